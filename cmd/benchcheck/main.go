@@ -0,0 +1,271 @@
+// Command benchcheck untuk membandingkan hasil go test -bench dengan baseline yang di-commit
+// exit code 1 jika ada benchmark yang lebih lambat atau alokasinya bertambah melebihi threshold
+//
+// contoh :
+//
+//	go test ./test -run '^$' -bench . -benchmem -count 5 | benchcheck -baseline test/testdata/bench/baseline.txt
+//	benchcheck -baseline test/testdata/bench/baseline.txt -threshold 0.5 current.txt
+//
+// jika benchmark dijalankan beberapa kali (-count), nilai yang dibandingkan adalah median nya
+// waktu (ns/op) bergantung pada mesin, jadi baseline sebaiknya dibuat di mesin yang sama dengan CI
+// sedangkan jumlah alokasi (allocs/op) tidak bergantung pada mesin dan menjadi budget alokasi
+//
+// exit code 0 jika tidak ada regresi, 1 jika ada regresi dan 2 jika argumen atau input tidak valid
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+const (
+	exitOK         = 0
+	exitRegression = 1
+	exitUsage      = 2
+)
+
+// satuan hasil benchmark yang dibandingkan
+const (
+	unitTime   = "ns/op"
+	unitBytes  = "B/op"
+	unitAllocs = "allocs/op"
+)
+
+// procsSuffix adalah akhiran GOMAXPROCS pada nama benchmark, contoh : BenchmarkX/success-8
+var procsSuffix = regexp.MustCompile(`-\d+$`)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// run untuk menjalankan command dan mengembalikan exit code
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("benchcheck", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprintln(stderr, "usage: benchcheck -baseline FILE [-threshold 0.25] [-bytes-threshold 0.10] [-allocs-threshold 0] [FILE]")
+		fmt.Fprintln(stderr, "current result is read from stdin when FILE is omitted or -")
+		flags.PrintDefaults()
+	}
+
+	baselineFile := flags.String("baseline", "", "file output go test -bench yang menjadi baseline")
+	thresholds := map[string]*float64{
+		unitTime:   flags.Float64("threshold", 0.25, "kenaikan ns/op maksimal, 0.25 berarti 25%"),
+		unitBytes:  flags.Float64("bytes-threshold", 0.10, "kenaikan B/op maksimal"),
+		unitAllocs: flags.Float64("allocs-threshold", 0, "kenaikan allocs/op maksimal"),
+	}
+
+	if err := flags.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	if *baselineFile == "" || flags.NArg() > 1 {
+		flags.Usage()
+		return exitUsage
+	}
+
+	baseline, err := readResults(*baselineFile, nil)
+	if err != nil {
+		fmt.Fprintf(stderr, "benchcheck: %v\n", err)
+		return exitUsage
+	}
+
+	current, err := readResults(flags.Arg(0), stdin)
+	if err != nil {
+		fmt.Fprintf(stderr, "benchcheck: %v\n", err)
+		return exitUsage
+	}
+
+	if len(current) == 0 {
+		fmt.Fprintln(stderr, "benchcheck: no benchmark result in input")
+		return exitUsage
+	}
+
+	limits := map[string]float64{}
+	for unit, threshold := range thresholds {
+		limits[unit] = *threshold
+	}
+
+	comparisons := compare(baseline, current, limits)
+	writeReport(stdout, comparisons)
+
+	for _, comparison := range comparisons {
+		if comparison.Regression {
+			return exitRegression
+		}
+	}
+
+	return exitOK
+}
+
+// results adalah nilai setiap satuan dari setiap benchmark, satu benchmark bisa punya beberapa nilai (-count)
+type results map[string]map[string][]float64
+
+// readResults untuk membaca output go test -bench dari file, atau dari stdin jika path kosong atau -
+func readResults(path string, stdin io.Reader) (results, error) {
+	reader := stdin
+	if path != "" && path != "-" {
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer file.Close()
+
+		reader = file
+	}
+
+	return parseResults(reader)
+}
+
+// parseResults untuk mengambil baris hasil benchmark, baris lain (goos, PASS, log) diabaikan
+// contoh baris : BenchmarkValidasiSlice/success-8   781234   1525 ns/op   97 B/op   3 allocs/op
+func parseResults(reader io.Reader) (results, error) {
+	parsed := results{}
+
+	scanner := bufio.NewScanner(reader)
+	for line := 1; scanner.Scan(); line++ {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 4 || !strings.HasPrefix(fields[0], "Benchmark") {
+			continue
+		}
+
+		if _, err := strconv.Atoi(fields[1]); err != nil {
+			continue
+		}
+
+		name := procsSuffix.ReplaceAllString(fields[0], "")
+		if parsed[name] == nil {
+			parsed[name] = map[string][]float64{}
+		}
+
+		for i := 2; i+1 < len(fields); i += 2 {
+			value, err := strconv.ParseFloat(fields[i], 64)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: invalid value %q for %s", line, fields[i], fields[i+1])
+			}
+			parsed[name][fields[i+1]] = append(parsed[name][fields[i+1]], value)
+		}
+	}
+
+	return parsed, scanner.Err()
+}
+
+// comparison adalah hasil perbandingan satu satuan dari satu benchmark
+type comparison struct {
+	Name       string
+	Unit       string
+	Baseline   float64
+	Current    float64
+	Delta      float64
+	Regression bool
+
+	// Missing berisi "baseline" jika benchmark baru, atau "current" jika benchmark tidak dijalankan
+	Missing string
+}
+
+// compare untuk membandingkan median setiap satuan dengan baseline, terurut berdasarkan nama benchmark
+// satuan yang tidak punya threshold tidak dibandingkan
+func compare(baseline, current results, limits map[string]float64) []comparison {
+	var names []string
+	for name := range baseline {
+		names = append(names, name)
+	}
+	for name := range current {
+		if _, ok := baseline[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+
+	var comparisons []comparison
+	for _, name := range names {
+		switch {
+		case baseline[name] == nil:
+			comparisons = append(comparisons, comparison{Name: name, Missing: "baseline"})
+			continue
+		case current[name] == nil:
+			comparisons = append(comparisons, comparison{Name: name, Missing: "current"})
+			continue
+		}
+
+		for _, unit := range []string{unitTime, unitBytes, unitAllocs} {
+			limit, ok := limits[unit]
+			if !ok || baseline[name][unit] == nil || current[name][unit] == nil {
+				continue
+			}
+
+			result := comparison{
+				Name:     name,
+				Unit:     unit,
+				Baseline: median(baseline[name][unit]),
+				Current:  median(current[name][unit]),
+			}
+
+			switch {
+			case result.Baseline > 0:
+				result.Delta = (result.Current - result.Baseline) / result.Baseline
+			case result.Current > 0:
+				// baseline tanpa alokasi yang sekarang punya alokasi selalu dianggap naik
+				result.Delta = 1
+			}
+			result.Regression = result.Delta > limit
+
+			comparisons = append(comparisons, result)
+		}
+	}
+
+	return comparisons
+}
+
+// median untuk nilai tengah dari hasil beberapa kali benchmark
+func median(values []float64) float64 {
+	sorted := slices.Clone(values)
+	slices.Sort(sorted)
+
+	middle := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[middle-1] + sorted[middle]) / 2
+	}
+	return sorted[middle]
+}
+
+// formatValue untuk menulis nilai dengan maksimal satu angka di belakang koma
+func formatValue(value float64) string {
+	return strconv.FormatFloat(math.Round(value*10)/10, 'f', -1, 64)
+}
+
+// writeReport untuk menulis tabel perbandingan, baris regresi ditandai REGRESSION
+func writeReport(writer io.Writer, comparisons []comparison) {
+	table := tabwriter.NewWriter(writer, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(table, "benchmark\tunit\tbaseline\tcurrent\tdelta\tstatus")
+
+	regressions := 0
+	for _, comparison := range comparisons {
+		if comparison.Missing != "" {
+			fmt.Fprintf(table, "%s\t-\t-\t-\t-\tmissing in %s\n", comparison.Name, comparison.Missing)
+			continue
+		}
+
+		status := "ok"
+		if comparison.Regression {
+			status = "REGRESSION"
+			regressions++
+		}
+
+		fmt.Fprintf(table, "%s\t%s\t%s\t%s\t%+.1f%%\t%s\n", comparison.Name, comparison.Unit,
+			formatValue(comparison.Baseline), formatValue(comparison.Current),
+			comparison.Delta*100, status)
+	}
+	table.Flush()
+
+	fmt.Fprintf(writer, "%d regression(s)\n", regressions)
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// baselineOutput adalah contoh output go test -bench yang dipakai sebagai baseline
+const baselineOutput = `goos: linux
+goarch: amd64
+pkg: go-validation/test
+BenchmarkValidasiSlice/success-8    	  781234	      1500 ns/op	      96 B/op	       3 allocs/op
+BenchmarkValidasiSlice/success-8    	  781234	      1520 ns/op	      96 B/op	       3 allocs/op
+BenchmarkValidasiSlice/success-8    	  781234	      9000 ns/op	      96 B/op	       3 allocs/op
+BenchmarkValidasiStruct/success-8   	 1000000	       383.3 ns/op	       0 B/op	       0 allocs/op
+PASS
+ok  	go-validation/test	4.940s
+`
+
+// TestRun untuk membandingkan hasil benchmark dengan baseline
+func TestRun(t *testing.T) {
+	baseline := filepath.Join(t.TempDir(), "baseline.txt")
+	assert.Nil(t, os.WriteFile(baseline, []byte(baselineOutput), 0o644))
+
+	scenario := []struct {
+		Name         string
+		Args         []string
+		Stdin        string
+		ExpectCode   int
+		ExpectStdout []string
+	}{
+		{
+			Name: "test benchcheck tanpa regresi",
+			Args: []string{"-baseline", baseline},
+			Stdin: "BenchmarkValidasiSlice/success-4 100 1600 ns/op 96 B/op 3 allocs/op\n" +
+				"BenchmarkValidasiStruct/success-4 100 400 ns/op 0 B/op 0 allocs/op\n",
+			ExpectCode:   exitOK,
+			ExpectStdout: []string{"BenchmarkValidasiSlice/success ns/op 1520 1600 +5.3% ok", "0 regression(s)"},
+		},
+		{
+			Name:         "test benchcheck waktu lebih lambat",
+			Args:         []string{"-baseline", baseline},
+			Stdin:        "BenchmarkValidasiSlice/success-4 100 2000 ns/op 96 B/op 3 allocs/op\n",
+			ExpectCode:   exitRegression,
+			ExpectStdout: []string{"+31.6% REGRESSION", "BenchmarkValidasiStruct/success - - - - missing in current", "1 regression(s)"},
+		},
+		{
+			Name:         "test benchcheck threshold waktu",
+			Args:         []string{"-baseline", baseline, "-threshold", "0.5"},
+			Stdin:        "BenchmarkValidasiSlice/success-4 100 2000 ns/op 96 B/op 3 allocs/op\n",
+			ExpectCode:   exitOK,
+			ExpectStdout: []string{"0 regression(s)"},
+		},
+		{
+			Name:         "test benchcheck alokasi bertambah",
+			Args:         []string{"-baseline", baseline},
+			Stdin:        "BenchmarkValidasiStruct/success-4 100 380 ns/op 16 B/op 1 allocs/op\n",
+			ExpectCode:   exitRegression,
+			ExpectStdout: []string{"allocs/op 0 1 +100.0% REGRESSION", "2 regression(s)"},
+		},
+		{
+			Name:         "test benchcheck benchmark baru",
+			Args:         []string{"-baseline", baseline},
+			Stdin:        "BenchmarkLargeSlice/success-4 100 4255617 ns/op 160073 B/op 10001 allocs/op\n",
+			ExpectCode:   exitOK,
+			ExpectStdout: []string{"BenchmarkLargeSlice/success - - - - missing in baseline"},
+		},
+		{
+			Name:       "test benchcheck tanpa baseline",
+			Stdin:      "BenchmarkValidasiSlice/success-4 100 2000 ns/op\n",
+			ExpectCode: exitUsage,
+		},
+		{
+			Name:       "test benchcheck input tanpa hasil benchmark",
+			Args:       []string{"-baseline", baseline},
+			Stdin:      "PASS\n",
+			ExpectCode: exitUsage,
+		},
+	}
+
+	for _, testScenario := range scenario {
+		t.Run(testScenario.Name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+
+			code := run(testScenario.Args, strings.NewReader(testScenario.Stdin), &stdout, &stderr)
+
+			assert.Equal(t, testScenario.ExpectCode, code, stderr.String())
+			// spasi dari tabwriter bergantung pada panjang kolom, bandingkan dengan satu spasi
+			output := strings.Join(strings.Fields(stdout.String()), " ")
+			for _, expect := range testScenario.ExpectStdout {
+				assert.Contains(t, output, expect)
+			}
+		})
+	}
+}
@@ -0,0 +1,161 @@
+// Command govalidate untuk validasi dokumen JSON menggunakan tag validate tanpa menulis test
+// semua custom tag dan alias dari package validation sudah terdaftar
+//
+// contoh :
+//
+//	echo '{"user1":"reo@gmail.com"}' | govalidate -tag "required,dive,keys,required,min=3,endkeys,required,email,min=12"
+//	govalidate -rules rules.yaml -format json request.json
+//
+// isi file rules berupa object path -> tag (lihat validation.Rules), misal :
+//
+//	username: required,email
+//	address.city: required
+//	addresses[].country: required,min=2
+//
+// exit code 0 jika valid, 1 jika validasi gagal dan 2 jika argumen atau input tidak valid
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+
+	"go-validation/validation"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	exitValid   = 0
+	exitInvalid = 1
+	exitUsage   = 2
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// run untuk menjalankan command dan mengembalikan exit code
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("govalidate", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprintln(stderr, "usage: govalidate (-tag TAG | -rules FILE) [-format text|json] [-locale en|id] [FILE]")
+		fmt.Fprintln(stderr, "document is read from stdin when FILE is omitted or -")
+		flags.PrintDefaults()
+	}
+
+	tag := flags.String("tag", "", "tag validasi untuk seluruh dokumen, misal required,dive,email")
+	rulesFile := flags.String("rules", "", "file YAML atau JSON berisi object path -> tag")
+	format := flags.String("format", "text", "format output: text atau json")
+	locale := flags.String("locale", validation.LocaleEnglish, "bahasa message: en atau id")
+
+	if err := flags.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	if (*tag == "") == (*rulesFile == "") {
+		fmt.Fprintln(stderr, "govalidate: exactly one of -tag or -rules is required")
+		flags.Usage()
+		return exitUsage
+	}
+
+	if !slices.Contains([]string{"text", "json"}, *format) {
+		fmt.Fprintf(stderr, "govalidate: unknown format %q\n", *format)
+		return exitUsage
+	}
+
+	if flags.NArg() > 1 {
+		fmt.Fprintln(stderr, "govalidate: only one document can be validated")
+		return exitUsage
+	}
+
+	document, err := readDocument(flags.Arg(0), stdin)
+	if err != nil {
+		fmt.Fprintf(stderr, "govalidate: %v\n", err)
+		return exitUsage
+	}
+
+	validate, err := validation.New()
+	if err != nil {
+		fmt.Fprintf(stderr, "govalidate: %v\n", err)
+		return exitUsage
+	}
+
+	catalog := validation.NewCatalog()
+	ctx := context.Background()
+
+	var fieldErrors []validation.FieldError
+	if *tag != "" {
+		fieldErrors, err = validateTag(ctx, validate, catalog, *locale, document, *tag)
+	} else {
+		fieldErrors, err = validateRules(ctx, validate, catalog, *locale, document, *rulesFile)
+	}
+
+	if err != nil {
+		fmt.Fprintf(stderr, "govalidate: %v\n", err)
+		return exitUsage
+	}
+
+	// error dari map tidak punya urutan yang pasti, urutkan berdasarkan field agar output stabil
+	// index dibandingkan sebagai angka supaya "items[2]" ditulis sebelum "items[10]"
+	slices.SortStableFunc(fieldErrors, func(a, b validation.FieldError) int {
+		return validation.ComparePath(a.Field, b.Field)
+	})
+
+	if err := writeResult(stdout, *format, fieldErrors); err != nil {
+		fmt.Fprintf(stderr, "govalidate: %v\n", err)
+		return exitUsage
+	}
+
+	if len(fieldErrors) > 0 {
+		return exitInvalid
+	}
+
+	return exitValid
+}
+
+// readDocument untuk membaca dokumen JSON dari file atau stdin
+func readDocument(path string, stdin io.Reader) (any, error) {
+	reader := stdin
+	if path != "" && path != "-" {
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer file.Close()
+
+		reader = file
+	}
+
+	var document any
+	decoder := json.NewDecoder(reader)
+	if err := decoder.Decode(&document); err != nil {
+		return nil, fmt.Errorf("invalid JSON document: %w", err)
+	}
+
+	if err := decoder.Decode(&struct{}{}); !errors.Is(err, io.EOF) {
+		return nil, errors.New("invalid JSON document: must only contain a single JSON value")
+	}
+
+	return document, nil
+}
+
+// readRules untuk membaca file rules berisi object path -> tag, boleh berisi object untuk nested rules
+func readRules(path string) (validation.Rules, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var rules validation.Rules
+	if err := yaml.Unmarshal(content, &rules); err != nil {
+		return nil, fmt.Errorf("invalid rules file %s: %w", path, err)
+	}
+
+	return rules, nil
+}
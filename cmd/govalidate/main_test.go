@@ -0,0 +1,131 @@
+package main
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestRunTag untuk validasi dokumen dari stdin menggunakan satu tag
+func TestRunTag(t *testing.T) {
+	scenario := []struct {
+		Name         string
+		Args         []string
+		Stdin        string
+		ExpectCode   int
+		ExpectStdout string
+	}{
+		{
+			Name:         "test govalidate basic map failed",
+			Args:         []string{"-tag", "required,dive,keys,required,min=3,endkeys,required,email,min=12"},
+			Stdin:        `{"user1": "user1", "user2": "user2@gmail.com", "a": "reo@gmail.com"}`,
+			ExpectCode:   exitInvalid,
+			ExpectStdout: "[a]: [a] must be at least 3 characters [FIELD_TOO_SHORT]\n[user1]: [user1] must be a valid email address, got 'user1' [INVALID_EMAIL]\n",
+		},
+		{
+			Name:         "test govalidate basic map success",
+			Args:         []string{"-tag", "required,dive,keys,required,endkeys,required,ip"},
+			Stdin:        `{"server1": "172.18.10.22"}`,
+			ExpectCode:   exitValid,
+			ExpectStdout: "valid\n",
+		},
+		{
+			Name:         "test govalidate urutan index",
+			Args:         []string{"-tag", "dive,dive,email"},
+			Stdin:        `{"b": ["reo@gmail.com", "reo@gmail.com", "x", "reo@gmail.com", "reo@gmail.com", "reo@gmail.com", "reo@gmail.com", "reo@gmail.com", "reo@gmail.com", "reo@gmail.com", "y"], "a": ["z"]}`,
+			ExpectCode:   exitInvalid,
+			ExpectStdout: "[a][0]: [a][0] must be a valid email address, got 'z' [INVALID_EMAIL]\n[b][2]: [b][2] must be a valid email address, got 'x' [INVALID_EMAIL]\n[b][10]: [b][10] must be a valid email address, got 'y' [INVALID_EMAIL]\n",
+		},
+		{
+			Name:         "test govalidate custom tag bahasa indonesia",
+			Args:         []string{"-tag", "gender", "-locale", "id", "-"},
+			Stdin:        `"mafale"`,
+			ExpectCode:   exitInvalid,
+			ExpectStdout: "(document): nilai harus salah satu dari [male female], bukan 'mafale' [INVALID_GENDER]\n",
+		},
+		{
+			Name:         "test govalidate format json",
+			Args:         []string{"-tag", "app_email", "-format", "json"},
+			Stdin:        `"reoo"`,
+			ExpectCode:   exitInvalid,
+			ExpectStdout: "{\n  \"valid\": false,\n  \"errors\": [\n    {\n      \"field\": \"\",\n      \"tag\": \"app_email\",\n      \"code\": \"INVALID_EMAIL\",\n      \"message\": \"value must be a valid email address, got 'reoo'\"\n    }\n  ]\n}\n",
+		},
+		{
+			Name:       "test govalidate tag tidak valid",
+			Args:       []string{"-tag", "requried"},
+			Stdin:      `"reo"`,
+			ExpectCode: exitUsage,
+		},
+		{
+			Name:       "test govalidate tanpa tag dan rules",
+			Stdin:      `"reo"`,
+			ExpectCode: exitUsage,
+		},
+		{
+			Name:       "test govalidate json tidak valid",
+			Args:       []string{"-tag", "required"},
+			Stdin:      `{"user1":`,
+			ExpectCode: exitUsage,
+		},
+	}
+
+	for _, testScenario := range scenario {
+		t.Run(testScenario.Name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+
+			code := run(testScenario.Args, strings.NewReader(testScenario.Stdin), &stdout, &stderr)
+
+			assert.Equal(t, testScenario.ExpectCode, code, stderr.String())
+			assert.Equal(t, testScenario.ExpectStdout, stdout.String())
+		})
+	}
+}
+
+// TestRunRules untuk validasi dokumen dari file menggunakan file rules
+func TestRunRules(t *testing.T) {
+	dir := t.TempDir()
+
+	rulesFile := filepath.Join(dir, "rules.yaml")
+	assert.Nil(t, os.WriteFile(rulesFile, []byte("username: required,email\npassword: required,min=6\nservers: required,dive,ip\naddress:\n  city: required\n"), 0o644))
+
+	scenario := []struct {
+		Name         string
+		Document     string
+		ExpectCode   int
+		ExpectStdout string
+	}{
+		{
+			Name:         "test govalidate rules failed",
+			Document:     `{"username": "reo", "address": {}, "servers": ["172.18.10.22", "qwert"]}`,
+			ExpectCode:   exitInvalid,
+			ExpectStdout: "address.city: city is required [FIELD_REQUIRED]\npassword: password is required [FIELD_REQUIRED]\nservers[1]: servers[1] must be a valid IP address, got 'qwert' [INVALID_IP_ADDRESS]\nusername: username must be a valid email address, got 'reo' [INVALID_EMAIL]\n",
+		},
+		{
+			Name:         "test govalidate rules success",
+			Document:     `{"username": "reo123@gmail.com", "password": "123456", "address": {"city": "Jakarta"}, "servers": ["172.18.10.22"]}`,
+			ExpectCode:   exitValid,
+			ExpectStdout: "valid\n",
+		},
+		{
+			Name:       "test govalidate rules dokumen bukan object",
+			Document:   `["reo"]`,
+			ExpectCode: exitUsage,
+		},
+	}
+
+	for _, testScenario := range scenario {
+		t.Run(testScenario.Name, func(t *testing.T) {
+			documentFile := filepath.Join(dir, "document.json")
+			assert.Nil(t, os.WriteFile(documentFile, []byte(testScenario.Document), 0o644))
+
+			var stdout, stderr bytes.Buffer
+			code := run([]string{"-rules", rulesFile, documentFile}, strings.NewReader(""), &stdout, &stderr)
+
+			assert.Equal(t, testScenario.ExpectCode, code, stderr.String())
+			assert.Equal(t, testScenario.ExpectStdout, stdout.String())
+		})
+	}
+}
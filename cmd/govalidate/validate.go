@@ -0,0 +1,103 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/go-playground/validator/v10"
+	"go-validation/validation"
+)
+
+// result adalah output dengan format json
+type result struct {
+	Valid  bool                    `json:"valid"`
+	Errors []validation.FieldError `json:"errors,omitempty"`
+}
+
+// validateTag untuk validasi seluruh dokumen menggunakan satu tag
+func validateTag(ctx context.Context, validate *validator.Validate, catalog *validation.Catalog, locale string, document any, tag string) ([]validation.FieldError, error) {
+	err := varCtx(ctx, validate, document, tag)
+	if err != nil && !isValidationErrors(err) {
+		return nil, err
+	}
+
+	return validation.FieldErrors(err, catalog, locale), nil
+}
+
+// validateRules untuk validasi dokumen object menggunakan rules dari file (lihat validation.Rules)
+// field pada error berupa path, misal "emails[0]" atau "address.city"
+func validateRules(ctx context.Context, validate *validator.Validate, catalog *validation.Catalog, locale string, document any, rulesFile string) ([]validation.FieldError, error) {
+	rules, err := readRules(rulesFile)
+	if err != nil {
+		return nil, err
+	}
+
+	object, ok := document.(map[string]any)
+	if !ok {
+		return nil, errors.New("document must be a JSON object when using -rules")
+	}
+
+	err = validateMap(ctx, validate, object, rules)
+	if err != nil && !isValidationErrors(err) {
+		return nil, err
+	}
+
+	return validation.FieldErrors(err, catalog, locale), nil
+}
+
+// validateMap untuk memanggil validation.ValidateMap dan mengubah panic karena tag tidak valid menjadi error
+func validateMap(ctx context.Context, validate *validator.Validate, object map[string]any, rules validation.Rules) (err error) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			err = fmt.Errorf("invalid rules: %v", recovered)
+		}
+	}()
+
+	return validation.ValidateMap(ctx, validate, object, rules)
+}
+
+// varCtx untuk memanggil validate.VarCtx dan mengubah panic karena tag tidak valid menjadi error
+func varCtx(ctx context.Context, validate *validator.Validate, value any, tag string) (err error) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			err = fmt.Errorf("invalid tag %q: %v", tag, recovered)
+		}
+	}()
+
+	return validation.VarCtx(ctx, validate, value, tag)
+}
+
+func isValidationErrors(err error) bool {
+	var validationErrors validator.ValidationErrors
+	return errors.As(err, &validationErrors)
+}
+
+// writeResult untuk menulis hasil validasi sesuai format
+func writeResult(writer io.Writer, format string, fieldErrors []validation.FieldError) error {
+	if format == "json" {
+		encoder := json.NewEncoder(writer)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(result{Valid: len(fieldErrors) == 0, Errors: fieldErrors})
+	}
+
+	if len(fieldErrors) == 0 {
+		_, err := fmt.Fprintln(writer, "valid")
+		return err
+	}
+
+	for _, fieldError := range fieldErrors {
+		field := fieldError.Field
+		if field == "" {
+			field = "(document)"
+		}
+
+		if _, err := fmt.Fprintf(writer, "%s: %s [%s]\n", field, fieldError.Message, fieldError.Code); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,95 @@
+// Command validategen untuk membuat method Validate(ctx) error tanpa reflection dari tag validate
+// dijalankan lewat go generate di file yang berisi struct
+//
+// contoh :
+//
+//	//go:generate go run go-validation/cmd/validategen -type LoginRequest,User
+//	//go:generate go run go-validation/cmd/validategen -type User -alias "username=required,alphanum,min=3"
+//
+// hasilnya ditulis ke <nama file>_validate.go di folder yang sama, kecuali diatur dengan -output
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"go-validation/validategen"
+)
+
+const (
+	exitOK    = 0
+	exitError = 1
+	exitUsage = 2
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stderr))
+}
+
+// run untuk menjalankan command dan mengembalikan exit code
+func run(args []string, stderr io.Writer) int {
+	flags := flag.NewFlagSet("validategen", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprintln(stderr, "usage: validategen -type T1,T2 [-output FILE] [-alias name=tags] [DIR]")
+		flags.PrintDefaults()
+	}
+
+	typeNames := flags.String("type", "", "nama struct yang dibuatkan method Validate, dipisah koma")
+	output := flags.String("output", "", "file hasil, default <GOFILE>_validate.go")
+	tagName := flags.String("tag", "validate", "nama struct tag yang dibaca")
+	aliases := map[string]string{}
+	flags.Func("alias", "alias tag tambahan dengan format name=tags, boleh diulang", func(value string) error {
+		name, tags, ok := strings.Cut(value, "=")
+		if !ok || name == "" || tags == "" {
+			return fmt.Errorf("alias must be name=tags")
+		}
+		aliases[name] = tags
+		return nil
+	})
+
+	if err := flags.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	if *typeNames == "" || flags.NArg() > 1 {
+		flags.Usage()
+		return exitUsage
+	}
+
+	dir := "."
+	if flags.NArg() == 1 {
+		dir = flags.Arg(0)
+	}
+
+	if *output == "" {
+		file := os.Getenv("GOFILE")
+		if file == "" {
+			file = strings.ToLower(strings.Split(*typeNames, ",")[0]) + ".go"
+		}
+		*output = filepath.Join(dir, validategen.DefaultOutput(file))
+	}
+
+	source, err := validategen.Generate(validategen.Config{
+		Dir:     dir,
+		Types:   strings.Split(*typeNames, ","),
+		Output:  filepath.Base(*output),
+		Aliases: aliases,
+		TagName: *tagName,
+	})
+	if err != nil {
+		fmt.Fprintln(stderr, "validategen:", err)
+		return exitError
+	}
+
+	if err := os.WriteFile(*output, source, 0o644); err != nil {
+		fmt.Fprintln(stderr, "validategen:", err)
+		return exitError
+	}
+
+	return exitOK
+}
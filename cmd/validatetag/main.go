@@ -0,0 +1,17 @@
+// Command validatetag untuk mengecek tag validate pada struct, bisa dijalankan sendiri atau lewat go vet
+//
+// contoh :
+//
+//	validatetag ./...
+//	go vet -vettool=$(which validatetag) ./...
+package main
+
+import (
+	"go-validation/validatetag"
+
+	"golang.org/x/tools/go/analysis/singlechecker"
+)
+
+func main() {
+	singlechecker.Main(validatetag.Analyzer)
+}
@@ -0,0 +1,274 @@
+package jsonschema
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// DefsPrefix adalah prefix $ref untuk definisi di dalam $defs
+const DefsPrefix = "#/$defs/"
+
+// TagMapper untuk menerjemahkan satu tag validate menjadi keyword JSON Schema
+// fieldType adalah type Go dari value yang divalidasi oleh tag (sudah melewati pointer dan dive)
+type TagMapper func(schema *Schema, param string, fieldType reflect.Type) error
+
+// Generator untuk membuat JSON Schema dari type Go
+// setiap struct bernama dibuat sebagai definisi sendiri dan direferensikan menggunakan $ref
+type Generator struct {
+	// TagName adalah nama struct tag aturan validasi, default "validate"
+	TagName string
+
+	// FieldNameTags adalah struct tag untuk nama property, dicek sesuai urutan, default "json"
+	FieldNameTags []string
+
+	// RefPrefix adalah prefix $ref untuk definisi struct, default DefsPrefix
+	RefPrefix string
+
+	mappers map[string]TagMapper
+	aliases map[string]string
+	names   map[reflect.Type]string
+	defs    map[string]*Schema
+}
+
+// NewGenerator untuk membuat generator yang sudah mengenal tag bawaan validator
+// serta custom tag dan alias dari package validation
+func NewGenerator() *Generator {
+	generator := &Generator{
+		TagName:       "validate",
+		FieldNameTags: []string{"json"},
+		RefPrefix:     DefsPrefix,
+		mappers:       make(map[string]TagMapper),
+		aliases:       make(map[string]string),
+		names:         make(map[reflect.Type]string),
+		defs:          make(map[string]*Schema),
+	}
+
+	for tag, mapper := range builtinMappers {
+		generator.Register(tag, mapper)
+	}
+	registerProjectTags(generator)
+
+	return generator
+}
+
+// Register untuk mendaftarkan mapper dari custom tag
+// contoh : generator.Register("gender", jsonschema.Enum("male", "female"))
+func (g *Generator) Register(tag string, mapper TagMapper) {
+	g.mappers[tag] = mapper
+}
+
+// RegisterAlias untuk mendaftarkan alias tag, sama seperti validate.RegisterAlias
+func (g *Generator) RegisterAlias(alias, tags string) {
+	g.aliases[alias] = tags
+}
+
+// Generate untuk membuat dokumen JSON Schema dari value
+// root dokumen berisi $ref ke type dari value dan $defs berisi semua struct yang dipakai
+func (g *Generator) Generate(value any) (*Schema, error) {
+	schema, err := g.TypeSchema(reflect.TypeOf(value))
+	if err != nil {
+		return nil, err
+	}
+
+	schema.Schema = Draft
+	schema.Defs = g.Definitions()
+	return schema, nil
+}
+
+// TypeSchema untuk membuat schema dari type Go
+// struct bernama dikembalikan sebagai $ref, definisinya diambil menggunakan Definitions
+func (g *Generator) TypeSchema(t reflect.Type) (*Schema, error) {
+	if t == nil {
+		return nil, fmt.Errorf("jsonschema: cannot generate schema from nil")
+	}
+
+	return g.typeSchema(t)
+}
+
+// Definitions untuk mengambil semua definisi struct yang sudah dibuat
+func (g *Generator) Definitions() map[string]*Schema {
+	defs := make(map[string]*Schema, len(g.defs))
+	for name, schema := range g.defs {
+		defs[name] = schema
+	}
+
+	return defs
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// typeSchema untuk membuat schema dasar dari type Go tanpa aturan validasi
+func (g *Generator) typeSchema(t reflect.Type) (*Schema, error) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	if t == timeType {
+		return &Schema{Type: "string", Format: "date-time"}, nil
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return &Schema{Type: "string"}, nil
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: "integer"}, nil
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}, nil
+	case reflect.Interface:
+		return &Schema{}, nil
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", Format: "byte"}, nil
+		}
+
+		items, err := g.typeSchema(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return &Schema{Type: "array", Items: items}, nil
+	case reflect.Map:
+		if t.Key().Kind() != reflect.String {
+			return nil, fmt.Errorf("jsonschema: map key of %s must be a string", t)
+		}
+
+		values, err := g.typeSchema(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return &Schema{Type: "object", AdditionalProperties: values}, nil
+	case reflect.Struct:
+		return g.structRef(t)
+	default:
+		return nil, fmt.Errorf("jsonschema: unsupported type %s", t)
+	}
+}
+
+// structRef untuk membuat definisi struct sekali saja lalu mengembalikan $ref nya
+// struct tanpa nama (anonymous) dibuat inline
+func (g *Generator) structRef(t reflect.Type) (*Schema, error) {
+	if t.Name() == "" {
+		return g.structSchema(t)
+	}
+
+	if name, ok := g.names[t]; ok {
+		return &Schema{Ref: g.RefPrefix + name}, nil
+	}
+
+	name := g.definitionName(t)
+	g.names[t] = name
+
+	// simpan placeholder lebih dulu agar struct rekursif tidak diproses ulang
+	schema := &Schema{}
+	g.defs[name] = schema
+
+	definition, err := g.structSchema(t)
+	if err != nil {
+		delete(g.defs, name)
+		delete(g.names, t)
+		return nil, err
+	}
+	*schema = *definition
+
+	return &Schema{Ref: g.RefPrefix + name}, nil
+}
+
+// definitionName untuk membuat nama definisi yang unik dari nama type
+// jika ada type lain dengan nama sama, ditambahkan angka di belakangnya
+func (g *Generator) definitionName(t reflect.Type) string {
+	name := t.Name()
+	for i := 2; ; i++ {
+		if _, exists := g.defs[name]; !exists {
+			return name
+		}
+		name = fmt.Sprintf("%s%d", t.Name(), i)
+	}
+}
+
+// structSchema untuk membuat schema object dari field struct beserta aturan validasinya
+func (g *Generator) structSchema(t reflect.Type) (*Schema, error) {
+	schema := &Schema{Type: "object", Properties: make(map[string]*Schema)}
+
+	if err := g.addFields(schema, t); err != nil {
+		return nil, err
+	}
+
+	return schema, nil
+}
+
+// addFields untuk menambahkan property dari setiap field struct
+// field embedded tanpa nama dari tag digabung ke object induk seperti encoding/json
+func (g *Generator) addFields(schema *Schema, t reflect.Type) error {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name, skip := g.propertyName(field)
+		if skip {
+			continue
+		}
+
+		tag := field.Tag.Get(g.TagName)
+		if tag == "-" {
+			tag = ""
+		}
+
+		fieldType := field.Type
+		for fieldType.Kind() == reflect.Pointer {
+			fieldType = fieldType.Elem()
+		}
+
+		if field.Anonymous && name == "" && fieldType.Kind() == reflect.Struct {
+			if err := g.addFields(schema, fieldType); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+
+		property, err := g.typeSchema(field.Type)
+		if err != nil {
+			return fmt.Errorf("field %s.%s: %w", t.Name(), field.Name, err)
+		}
+
+		required, err := g.applyTags(property, tag, field.Type)
+		if err != nil {
+			return fmt.Errorf("field %s.%s: %w", t.Name(), field.Name, err)
+		}
+
+		schema.Properties[name] = property
+		if required {
+			schema.Required = append(schema.Required, name)
+		}
+	}
+
+	return nil
+}
+
+// propertyName untuk mengambil nama property dari FieldNameTags
+// skip bernilai true jika tag pertama yang ada bernilai "-"
+func (g *Generator) propertyName(field reflect.StructField) (string, bool) {
+	for _, tagName := range g.FieldNameTags {
+		value, ok := field.Tag.Lookup(tagName)
+		if !ok {
+			continue
+		}
+
+		name, _, _ := strings.Cut(value, ",")
+		if name == "-" {
+			return "", true
+		}
+		return name, false
+	}
+
+	return "", false
+}
@@ -0,0 +1,51 @@
+// Package jsonschema untuk membuat JSON Schema (draft 2020-12) dari tag validate pada struct
+// aturan seperti required, min/max, email, ip, oneof, dive dan keys/endkeys diterjemahkan ke keyword JSON Schema
+// custom tag dipetakan menggunakan Generator.Register
+package jsonschema
+
+// Draft adalah URI dialect JSON Schema yang dihasilkan
+const Draft = "https://json-schema.org/draft/2020-12/schema"
+
+// Schema adalah satu object JSON Schema
+// hanya keyword yang dipakai oleh generator yang tersedia
+type Schema struct {
+	Schema string             `json:"$schema,omitempty"`
+	Ref    string             `json:"$ref,omitempty"`
+	Defs   map[string]*Schema `json:"$defs,omitempty"`
+
+	Type        string `json:"type,omitempty"`
+	Format      string `json:"format,omitempty"`
+	Pattern     string `json:"pattern,omitempty"`
+	Enum        []any  `json:"enum,omitempty"`
+	Const       any    `json:"const,omitempty"`
+	Description string `json:"description,omitempty"`
+
+	MinLength        *int     `json:"minLength,omitempty"`
+	MaxLength        *int     `json:"maxLength,omitempty"`
+	Minimum          *float64 `json:"minimum,omitempty"`
+	Maximum          *float64 `json:"maximum,omitempty"`
+	ExclusiveMinimum *float64 `json:"exclusiveMinimum,omitempty"`
+	ExclusiveMaximum *float64 `json:"exclusiveMaximum,omitempty"`
+
+	Items       *Schema `json:"items,omitempty"`
+	MinItems    *int    `json:"minItems,omitempty"`
+	MaxItems    *int    `json:"maxItems,omitempty"`
+	UniqueItems bool    `json:"uniqueItems,omitempty"`
+
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	PropertyNames        *Schema            `json:"propertyNames,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	MinProperties        *int               `json:"minProperties,omitempty"`
+	MaxProperties        *int               `json:"maxProperties,omitempty"`
+
+	AnyOf []*Schema `json:"anyOf,omitempty"`
+}
+
+func intPointer(value int) *int {
+	return &value
+}
+
+func floatPointer(value float64) *float64 {
+	return &value
+}
@@ -0,0 +1,470 @@
+package jsonschema
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"go-validation/validation"
+)
+
+// builtinMappers berisi mapper untuk tag bawaan validator
+// tag yang tidak punya padanan di JSON Schema (misal eqfield) diabaikan
+var builtinMappers = map[string]TagMapper{
+	"min":      lengthOrValue(setMinimum),
+	"max":      lengthOrValue(setMaximum),
+	"len":      lengthOrValue(setLength),
+	"gte":      lengthOrValue(setMinimum),
+	"lte":      lengthOrValue(setMaximum),
+	"gt":       lengthOrValue(setExclusiveMinimum),
+	"lt":       lengthOrValue(setExclusiveMaximum),
+	"eq":       mapEqual,
+	"oneof":    mapOneOf,
+	"email":    Format("email"),
+	"ipv4":     Format("ipv4"),
+	"ipv6":     Format("ipv6"),
+	"ip":       AnyOf(Format("ipv4"), Format("ipv6")),
+	"url":      Format("uri"),
+	"uri":      Format("uri"),
+	"uuid":     Format("uuid"),
+	"hostname": Format("hostname"),
+	"alpha":    Pattern("^[a-zA-Z]+$"),
+	"alphanum": Pattern("^[a-zA-Z0-9]+$"),
+	"numeric":  Pattern(`^[-+]?[0-9]+(?:\.[0-9]+)?$`),
+	"unique":   mapUnique,
+}
+
+// registerProjectTags untuk mendaftarkan custom tag dan alias dari package validation
+func registerProjectTags(generator *Generator) {
+	generator.Register(validation.TagGender, setEnum(validation.SetGender))
+	generator.Register(validation.TagCategory, setEnum(validation.SetCategory))
+	generator.Register(validation.TagMinCategory, func(schema *Schema, param string, fieldType reflect.Type) error {
+		if schema.Items == nil {
+			return fmt.Errorf("tag %s can only be used on a slice", validation.TagMinCategory)
+		}
+
+		schema.Items.Enum = stringsToAny(validation.CategoryOptions)
+		return lengthOrValue(setMinimum)(schema, param, fieldType)
+	})
+	generator.Register(validation.TagInSet, func(schema *Schema, param string, fieldType reflect.Type) error {
+		return setEnum(param)(schema, param, fieldType)
+	})
+	generator.RegisterAlias(validation.AliasAppEmail, validation.AppEmailTags)
+}
+
+// setEnum untuk membuat mapper yang membatasi value ke set dari package validation
+// set diambil saat schema dibuat, jadi set yang dibaca dari config tetap terpakai
+func setEnum(name string) TagMapper {
+	return func(schema *Schema, param string, fieldType reflect.Type) error {
+		set, ok := validation.LookupSet(name)
+		if !ok {
+			return fmt.Errorf("set %q is not registered", name)
+		}
+
+		schema.Enum = stringsToAny(set.Values)
+		return nil
+	}
+}
+
+// Enum untuk membuat mapper yang membatasi value ke daftar values
+func Enum(values ...any) TagMapper {
+	return func(schema *Schema, param string, fieldType reflect.Type) error {
+		schema.Enum = values
+		return nil
+	}
+}
+
+// Format untuk membuat mapper yang mengisi keyword format
+func Format(format string) TagMapper {
+	return func(schema *Schema, param string, fieldType reflect.Type) error {
+		schema.Format = format
+		return nil
+	}
+}
+
+// Pattern untuk membuat mapper yang mengisi keyword pattern
+func Pattern(pattern string) TagMapper {
+	return func(schema *Schema, param string, fieldType reflect.Type) error {
+		schema.Pattern = pattern
+		return nil
+	}
+}
+
+// AnyOf untuk membuat mapper yang menghasilkan anyOf dari beberapa mapper
+func AnyOf(mappers ...TagMapper) TagMapper {
+	return func(schema *Schema, param string, fieldType reflect.Type) error {
+		for _, mapper := range mappers {
+			alternative := &Schema{}
+			if err := mapper(alternative, param, fieldType); err != nil {
+				return err
+			}
+			schema.AnyOf = append(schema.AnyOf, alternative)
+		}
+		return nil
+	}
+}
+
+// applyTags untuk menerapkan tag validate ke schema dari field
+// mengembalikan true jika field memakai tag required di level paling luar
+func (g *Generator) applyTags(schema *Schema, tag string, fieldType reflect.Type) (bool, error) {
+	tags := g.expandAliases(splitTags(tag), 0)
+
+	required := false
+	current, currentType, rawType := schema, indirect(fieldType), fieldType
+	var parent *Schema
+	var optionals []optionalSchema
+
+	for i := 0; i < len(tags); i++ {
+		name, param, _ := strings.Cut(tags[i], "=")
+		param = strings.ReplaceAll(param, "0x2C", ",")
+
+		switch name {
+		case "", "omitnil", "structonly", "nostructlevel":
+		case "omitempty":
+			optionals = append(optionals, optionalSchema{schema: current, base: *current, fieldType: rawType})
+		case "required":
+			if current == schema {
+				required = true
+			}
+			setNonEmpty(current, currentType)
+		case "dive":
+			var err error
+			parent = current
+			if kind := currentType.Kind(); kind == reflect.Slice || kind == reflect.Array || kind == reflect.Map {
+				rawType = currentType.Elem()
+			}
+			current, currentType, err = dive(current, currentType)
+			if err != nil {
+				return false, err
+			}
+		case "keys":
+			end := indexOf(tags[i:], "endkeys")
+			if end == -1 {
+				return false, fmt.Errorf("tag keys must be closed with endkeys")
+			}
+			if i == 0 || tags[i-1] != "dive" || parent.PropertyNames != nil || parent.Type != "object" {
+				return false, fmt.Errorf("tag keys must directly follow dive on a map")
+			}
+
+			// current sudah berada di value map, propertyNames ada di schema map nya
+			keys := &Schema{Type: "string"}
+			if err := g.applyMappers(keys, tags[i+1:i+end], reflect.TypeOf("")); err != nil {
+				return false, err
+			}
+			parent.PropertyNames = keys
+			i += end
+		case "endkeys":
+			return false, fmt.Errorf("tag endkeys without keys")
+		default:
+			if err := g.applyMappers(current, []string{tags[i]}, currentType); err != nil {
+				return false, err
+			}
+		}
+	}
+
+	for i := len(optionals) - 1; i >= 0; i-- {
+		optionals[i].allowZero()
+	}
+
+	return required, nil
+}
+
+// optionalSchema adalah schema yang aturannya dilewati validator jika value nya kosong (omitempty)
+// base adalah isi schema sebelum tag omitempty, fieldType adalah type value sebelum melewati pointer
+type optionalSchema struct {
+	schema    *Schema
+	base      Schema
+	fieldType reflect.Type
+}
+
+// allowZero untuk menerima zero value pada schema dengan omitempty, contoh : omitempty,min=3 pada string
+// menjadi anyOf [{const: ""}, {minLength: 3}] karena validator tidak memeriksa string kosong
+// hanya untuk string, angka dan bool, zero value pointer, slice dan map adalah null atau property yang tidak dikirim
+func (o optionalSchema) allowZero() {
+	zero, ok := zeroValue(o.fieldType)
+	if !ok || reflect.DeepEqual(*o.schema, o.base) {
+		return
+	}
+
+	constrained := *o.schema
+	constrained.Type = ""
+
+	*o.schema = o.base
+	o.schema.AnyOf = []*Schema{{Const: zero}, &constrained}
+}
+
+// zeroValue untuk mengambil zero value dari type yang bisa ditulis di JSON
+func zeroValue(fieldType reflect.Type) (any, bool) {
+	switch fieldType.Kind() {
+	case reflect.String:
+		return "", true
+	case reflect.Bool:
+		return false, true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return 0, true
+	default:
+		return nil, false
+	}
+}
+
+// applyMappers untuk menerapkan mapper dari setiap tag
+// tag dengan | (or) menjadi anyOf, tag yang tidak dikenal diabaikan
+func (g *Generator) applyMappers(schema *Schema, tags []string, fieldType reflect.Type) error {
+	for _, tag := range tags {
+		if alternatives := strings.Split(tag, "|"); len(alternatives) > 1 {
+			for _, alternative := range alternatives {
+				option := &Schema{}
+				if err := g.applyMappers(option, []string{alternative}, fieldType); err != nil {
+					return err
+				}
+				schema.AnyOf = append(schema.AnyOf, option)
+			}
+			continue
+		}
+
+		name, param, _ := strings.Cut(tag, "=")
+		if name == "required" {
+			setNonEmpty(schema, fieldType)
+			continue
+		}
+
+		mapper, ok := g.mappers[name]
+		if !ok {
+			continue
+		}
+
+		if err := mapper(schema, strings.ReplaceAll(param, "0x2C", ","), fieldType); err != nil {
+			return fmt.Errorf("tag %s: %w", tag, err)
+		}
+	}
+
+	return nil
+}
+
+// expandAliases untuk mengganti alias dengan tag aslinya, alias boleh berisi alias lain
+func (g *Generator) expandAliases(tags []string, depth int) []string {
+	if depth > 10 {
+		return tags
+	}
+
+	expanded := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		if alias, ok := g.aliases[tag]; ok {
+			expanded = append(expanded, g.expandAliases(splitTags(alias), depth+1)...)
+			continue
+		}
+		expanded = append(expanded, tag)
+	}
+
+	return expanded
+}
+
+// dive untuk berpindah ke schema item dari slice atau value dari map
+func dive(schema *Schema, fieldType reflect.Type) (*Schema, reflect.Type, error) {
+	switch fieldType.Kind() {
+	case reflect.Slice, reflect.Array:
+		if schema.Items == nil {
+			return nil, nil, fmt.Errorf("tag dive cannot be used on %s", fieldType)
+		}
+		return schema.Items, indirect(fieldType.Elem()), nil
+	case reflect.Map:
+		return schema.AdditionalProperties, indirect(fieldType.Elem()), nil
+	default:
+		return nil, nil, fmt.Errorf("tag dive can only be used on a slice, array or map, not %s", fieldType)
+	}
+}
+
+// setNonEmpty untuk aturan required pada value, string tidak boleh kosong
+func setNonEmpty(schema *Schema, fieldType reflect.Type) {
+	if fieldType.Kind() == reflect.String && schema.MinLength == nil {
+		schema.MinLength = intPointer(1)
+	}
+}
+
+type boundSetter func(schema *Schema, kind string, value float64)
+
+// lengthOrValue untuk membuat mapper yang membatasi panjang string, jumlah item,
+// jumlah property atau nilai angka sesuai kind dari field
+func lengthOrValue(setter boundSetter) TagMapper {
+	return func(schema *Schema, param string, fieldType reflect.Type) error {
+		kind := boundKind(fieldType)
+		if kind == "" {
+			return nil
+		}
+
+		var value float64
+		var err error
+		if kind == "number" {
+			value, err = strconv.ParseFloat(param, 64)
+		} else {
+			var length int
+			length, err = strconv.Atoi(param)
+			value = float64(length)
+		}
+		if err != nil {
+			return fmt.Errorf("invalid param %q", param)
+		}
+
+		setter(schema, kind, value)
+		return nil
+	}
+}
+
+func setMinimum(schema *Schema, kind string, value float64) {
+	switch kind {
+	case "string":
+		schema.MinLength = intPointer(int(value))
+	case "array":
+		schema.MinItems = intPointer(int(value))
+	case "object":
+		schema.MinProperties = intPointer(int(value))
+	default:
+		schema.Minimum = floatPointer(value)
+	}
+}
+
+func setMaximum(schema *Schema, kind string, value float64) {
+	switch kind {
+	case "string":
+		schema.MaxLength = intPointer(int(value))
+	case "array":
+		schema.MaxItems = intPointer(int(value))
+	case "object":
+		schema.MaxProperties = intPointer(int(value))
+	default:
+		schema.Maximum = floatPointer(value)
+	}
+}
+
+func setLength(schema *Schema, kind string, value float64) {
+	setMinimum(schema, kind, value)
+	setMaximum(schema, kind, value)
+}
+
+func setExclusiveMinimum(schema *Schema, kind string, value float64) {
+	if kind == "number" {
+		schema.ExclusiveMinimum = floatPointer(value)
+		return
+	}
+	setMinimum(schema, kind, value+1)
+}
+
+func setExclusiveMaximum(schema *Schema, kind string, value float64) {
+	if kind == "number" {
+		schema.ExclusiveMaximum = floatPointer(value)
+		return
+	}
+	setMaximum(schema, kind, value-1)
+}
+
+// mapEqual untuk tag eq, string dan angka menjadi const, selain itu menjadi panjang yang pasti
+func mapEqual(schema *Schema, param string, fieldType reflect.Type) error {
+	switch boundKind(fieldType) {
+	case "number":
+		value, err := parseValue(param, fieldType)
+		if err != nil {
+			return err
+		}
+		schema.Const = value
+		return nil
+	case "string":
+		schema.Const = param
+		return nil
+	default:
+		return lengthOrValue(setLength)(schema, param, fieldType)
+	}
+}
+
+// mapOneOf untuk tag oneof, setiap value dipisah spasi dan diubah sesuai type field
+func mapOneOf(schema *Schema, param string, fieldType reflect.Type) error {
+	for _, raw := range strings.Fields(param) {
+		value, err := parseValue(strings.Trim(raw, "'"), fieldType)
+		if err != nil {
+			return err
+		}
+		schema.Enum = append(schema.Enum, value)
+	}
+	return nil
+}
+
+// mapUnique untuk tag unique pada slice
+func mapUnique(schema *Schema, param string, fieldType reflect.Type) error {
+	if boundKind(fieldType) == "array" && param == "" {
+		schema.UniqueItems = true
+	}
+	return nil
+}
+
+// boundKind untuk mengelompokkan kind field menjadi string, array, object atau number
+func boundKind(fieldType reflect.Type) string {
+	switch fieldType.Kind() {
+	case reflect.String:
+		return "string"
+	case reflect.Slice, reflect.Array:
+		return "array"
+	case reflect.Map:
+		return "object"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return "number"
+	default:
+		return ""
+	}
+}
+
+// parseValue untuk mengubah param menjadi value sesuai type field
+func parseValue(param string, fieldType reflect.Type) (any, error) {
+	switch fieldType.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		value, err := strconv.ParseInt(param, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid param %q", param)
+		}
+		return value, nil
+	case reflect.Float32, reflect.Float64:
+		value, err := strconv.ParseFloat(param, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid param %q", param)
+		}
+		return value, nil
+	default:
+		return param, nil
+	}
+}
+
+// splitTags untuk memecah tag validate menjadi list tag
+func splitTags(tag string) []string {
+	if tag == "" {
+		return nil
+	}
+	return strings.Split(tag, ",")
+}
+
+func indexOf(tags []string, tag string) int {
+	for i, value := range tags {
+		if value == tag {
+			return i
+		}
+	}
+	return -1
+}
+
+func indirect(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t
+}
+
+func stringsToAny(values []string) []any {
+	result := make([]any, 0, len(values))
+	for _, value := range values {
+		result = append(result, value)
+	}
+	return result
+}
@@ -0,0 +1,106 @@
+// Package openapi untuk membuat dokumen OpenAPI 3.1 dari type request dan response
+// schema di components.schemas dibuat dari tag validate dan nama property dari tag json
+// sehingga dokumentasi API selalu sama dengan aturan yang dijalankan validator
+package openapi
+
+import (
+	"fmt"
+	"reflect"
+
+	"go-validation/jsonschema"
+)
+
+// Version adalah versi OpenAPI yang dihasilkan
+const Version = "3.1.0"
+
+// SchemasPrefix adalah prefix $ref untuk schema di components.schemas
+const SchemasPrefix = "#/components/schemas/"
+
+// Document adalah dokumen OpenAPI, hanya berisi info dan components.schemas
+type Document struct {
+	OpenAPI           string     `json:"openapi"`
+	Info              Info       `json:"info"`
+	JSONSchemaDialect string     `json:"jsonSchemaDialect"`
+	Components        Components `json:"components"`
+}
+
+// Info adalah informasi dari API
+type Info struct {
+	Title       string `json:"title"`
+	Version     string `json:"version"`
+	Description string `json:"description,omitempty"`
+}
+
+// Components berisi schema dari setiap type yang didaftarkan beserta struct yang dipakai di dalamnya
+type Components struct {
+	Schemas map[string]*jsonschema.Schema `json:"schemas"`
+}
+
+// Builder untuk mendaftarkan type request dan response lalu membuat Document
+type Builder struct {
+	info      Info
+	generator *jsonschema.Generator
+}
+
+// NewBuilder untuk membuat builder dengan judul dan versi API
+// contoh : builder := openapi.NewBuilder("User API", "1.0.0")
+func NewBuilder(title, version string) *Builder {
+	generator := jsonschema.NewGenerator()
+	generator.RefPrefix = SchemasPrefix
+
+	return &Builder{
+		info:      Info{Title: title, Version: version},
+		generator: generator,
+	}
+}
+
+// Generator untuk mengambil generator JSON Schema yang dipakai builder
+// misal untuk mendaftarkan mapper dari custom tag menggunakan Register
+func (b *Builder) Generator() *jsonschema.Generator {
+	return b.generator
+}
+
+// Describe untuk mengisi deskripsi API
+func (b *Builder) Describe(description string) *Builder {
+	b.info.Description = description
+	return b
+}
+
+// Register untuk mendaftarkan type request atau response, value harus struct bernama atau pointer ke struct
+// contoh : builder.Register(LoginRequest{}, &User{})
+func (b *Builder) Register(values ...any) error {
+	for _, value := range values {
+		t := reflect.TypeOf(value)
+		for t != nil && t.Kind() == reflect.Pointer {
+			t = t.Elem()
+		}
+
+		if t == nil || t.Kind() != reflect.Struct || t.Name() == "" {
+			return fmt.Errorf("openapi: %T must be a named struct", value)
+		}
+
+		if _, err := b.generator.TypeSchema(t); err != nil {
+			return fmt.Errorf("openapi: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Ref untuk mengambil $ref dari type, misal untuk dipakai di paths
+// type yang belum didaftarkan akan ikut didaftarkan
+func (b *Builder) Ref(value any) (*jsonschema.Schema, error) {
+	return b.generator.TypeSchema(reflect.TypeOf(value))
+}
+
+// Document untuk membuat dokumen OpenAPI dari semua type yang sudah didaftarkan
+func (b *Builder) Document() *Document {
+	return &Document{
+		OpenAPI:           Version,
+		Info:              b.info,
+		JSONSchemaDialect: jsonschema.Draft,
+		Components: Components{
+			Schemas: b.generator.Definitions(),
+		},
+	}
+}
@@ -0,0 +1,331 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-playground/validator/v10"
+	"go-validation/test/model"
+	"go-validation/validation"
+	"testing"
+)
+
+// benchmark dijalankan dan dibandingkan dengan baseline menggunakan cmd/benchcheck :
+//
+//	go test ./test -run '^$' -bench . -benchmem -count 5 | go run ./cmd/benchcheck -baseline test/testdata/bench/baseline.txt
+//
+// untuk memperbarui baseline, simpan output go test di atas ke test/testdata/bench/baseline.txt
+
+// benchmarkSize adalah jumlah item untuk benchmark input besar
+const benchmarkSize = 10_000
+
+// benchmarkDepth adalah kedalaman struct untuk benchmark nested
+const benchmarkDepth = 100
+
+// BenchmarkNode adalah struct rekursif untuk benchmark struct yang sangat dalam
+type BenchmarkNode struct {
+	Name  string `validate:"required,min=2"`
+	Child *BenchmarkNode
+}
+
+// benchmarkScenario adalah satu skenario benchmark
+type benchmarkScenario struct {
+	Name string
+	Run  func(ctx context.Context) error
+}
+
+// runBenchmarks untuk menjalankan setiap skenario sebagai sub benchmark dengan laporan alokasi
+func runBenchmarks(b *testing.B, scenarios []benchmarkScenario) {
+	for _, benchmarkScenario := range scenarios {
+		b.Run(benchmarkScenario.Name, func(b *testing.B) {
+			ctx := context.Background()
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				_ = benchmarkScenario.Run(ctx)
+			}
+		})
+	}
+}
+
+// newBenchmarkValidator untuk membuat validator dengan custom tag dari validation.New
+func newBenchmarkValidator(b *testing.B) *validator.Validate {
+	validate, err := validation.New()
+	if err != nil {
+		b.Fatal(err)
+	}
+	return validate
+}
+
+// structScenario untuk skenario validasi struct dengan validate.StructCtx
+func structScenario(validate *validator.Validate, name string, value any) benchmarkScenario {
+	return benchmarkScenario{Name: name, Run: func(ctx context.Context) error {
+		return validate.StructCtx(ctx, value)
+	}}
+}
+
+// varScenario untuk skenario validasi variable dengan validate.VarCtx
+func varScenario(validate *validator.Validate, name string, value any, tag string) benchmarkScenario {
+	return benchmarkScenario{Name: name, Run: func(ctx context.Context) error {
+		return validate.VarCtx(ctx, value, tag)
+	}}
+}
+
+// BenchmarkValidationStruct sama dengan TestValidationStruct
+func BenchmarkValidationStruct(b *testing.B) {
+	validate := newBenchmarkValidator(b)
+
+	runBenchmarks(b, []benchmarkScenario{
+		structScenario(validate, "success", model.Customer{Nama: "reo"}),
+		structScenario(validate, "failed", model.Customer{Nama: "n"}),
+	})
+}
+
+// BenchmarkValidateVariable sama dengan TestValidateVariable
+func BenchmarkValidateVariable(b *testing.B) {
+	validate := newBenchmarkValidator(b)
+
+	runBenchmarks(b, []benchmarkScenario{
+		varScenario(validate, "success", "reo", "required"),
+		varScenario(validate, "failed", "", "required"),
+	})
+}
+
+// BenchmarkValidasiDuaVariable sama dengan TestValidasiDuaVariable
+func BenchmarkValidasiDuaVariable(b *testing.B) {
+	validate := newBenchmarkValidator(b)
+
+	runBenchmarks(b, []benchmarkScenario{
+		{Name: "success", Run: func(ctx context.Context) error {
+			return validate.VarWithValueCtx(ctx, "123456", "123456", "eqfield")
+		}},
+		{Name: "failed", Run: func(ctx context.Context) error {
+			return validate.VarWithValueCtx(ctx, "123456", "123", "eqfield")
+		}},
+	})
+}
+
+// BenchmarkBackedInValidation sama dengan TestBackedInValidation
+func BenchmarkBackedInValidation(b *testing.B) {
+	validate := newBenchmarkValidator(b)
+
+	runBenchmarks(b, []benchmarkScenario{
+		varScenario(validate, "required", "hello world", "required"),
+		varScenario(validate, "ip success", "172.18.41.238", "ip"),
+		varScenario(validate, "ip failed", "172.www", "ip"),
+	})
+}
+
+// BenchmarkMultipleTagValidation sama dengan TestMultipleTagValidation
+func BenchmarkMultipleTagValidation(b *testing.B) {
+	validate := newBenchmarkValidator(b)
+
+	runBenchmarks(b, []benchmarkScenario{
+		varScenario(validate, "success", "172.18.231.248", "required,min=3,ip"),
+		varScenario(validate, "failed", "reo", "required,min=3,ip"),
+	})
+}
+
+// BenchmarkTagParameter sama dengan TestTagParameter
+func BenchmarkTagParameter(b *testing.B) {
+	validate := newBenchmarkValidator(b)
+
+	runBenchmarks(b, []benchmarkScenario{
+		varScenario(validate, "success", "reo s", "required,min=3,max=10"),
+		varScenario(validate, "failed", "re", "required,min=3,max=10"),
+	})
+}
+
+// BenchmarkValidasiStruct sama dengan TestValidasiStruct, juga membandingkan dengan method hasil validategen
+func BenchmarkValidasiStruct(b *testing.B) {
+	validate := newBenchmarkValidator(b)
+	valid := &model.LoginRequest{Username: "reo@gmail.com", Password: "rahasia"}
+	invalid := &model.LoginRequest{Username: "reo", Password: "123"}
+
+	runBenchmarks(b, []benchmarkScenario{
+		structScenario(validate, "success", valid),
+		structScenario(validate, "failed", invalid),
+		{Name: "generated success", Run: valid.Validate},
+		{Name: "generated failed", Run: invalid.Validate},
+	})
+}
+
+// BenchmarkValidationErrors sama dengan TestValidationErrors, termasuk membuat message dari catalog
+func BenchmarkValidationErrors(b *testing.B) {
+	validate := newBenchmarkValidator(b)
+	catalog := validation.NewCatalog()
+	invalid := model.LoginRequet{Username: "a@b", Password: "abc123"}
+
+	runBenchmarks(b, []benchmarkScenario{
+		structScenario(validate, "failed", invalid),
+		{Name: "messages", Run: func(ctx context.Context) error {
+			_ = catalog.Messages(validate.StructCtx(ctx, invalid))
+			return nil
+		}},
+	})
+}
+
+// BenchmarkValidasiNestedStruct sama dengan TestValidasiNestedStruct
+func BenchmarkValidasiNestedStruct(b *testing.B) {
+	validate := newBenchmarkValidator(b)
+
+	runBenchmarks(b, []benchmarkScenario{
+		structScenario(validate, "success", model.User{Name: "reo", Address: &model.Address{City: "Jakarta", Country: "Indonesia"}}),
+		structScenario(validate, "failed", model.User{Address: &model.Address{}}),
+		structScenario(validate, "nil", model.User{Name: "reo"}),
+	})
+}
+
+// BenchmarkValidasiSlice sama dengan TestValidasiSlice
+func BenchmarkValidasiSlice(b *testing.B) {
+	validate := newBenchmarkValidator(b)
+
+	runBenchmarks(b, []benchmarkScenario{
+		structScenario(validate, "success", model.Member{Name: "reo", Addresses: []model.MemberAddress{{City: "Jakarta", Country: "ID"}, {City: "Bandung", Country: "ID"}}}),
+		structScenario(validate, "failed", model.Member{Name: "reo", Addresses: []model.MemberAddress{{City: "J", Country: ""}, {City: "Bandung", Country: "I"}}}),
+	})
+}
+
+// BenchmarkValidasiBasicSlice sama dengan TestValidasiBasicSlice
+func BenchmarkValidasiBasicSlice(b *testing.B) {
+	validate := newBenchmarkValidator(b)
+
+	runBenchmarks(b, []benchmarkScenario{
+		structScenario(validate, "success", model.Server{Name: "db", IPAddresses: []string{"172.18.10.22", "172.18.10.23"}}),
+		structScenario(validate, "failed", model.Server{Name: "db", IPAddresses: []string{"172.18.10", ""}}),
+	})
+}
+
+// BenchmarkValidasiMap sama dengan TestValidasiMap
+func BenchmarkValidasiMap(b *testing.B) {
+	validate := newBenchmarkValidator(b)
+	tag := "required,dive,keys,min=2,endkeys,required"
+
+	runBenchmarks(b, []benchmarkScenario{
+		varScenario(validate, "success", map[string]*model.School{"sd": {Name: "SD N 1", Address: "Jakarta Selatan"}}, tag),
+		varScenario(validate, "failed", map[string]*model.School{"s": {Name: "a", Address: "a"}}, tag),
+	})
+}
+
+// BenchmarkValidasiBasicMap sama dengan TestValidasiBasicMap
+func BenchmarkValidasiBasicMap(b *testing.B) {
+	validate := newBenchmarkValidator(b)
+
+	runBenchmarks(b, []benchmarkScenario{
+		varScenario(validate, "success", map[string]string{
+			"server1": "172.18.10.22", "server2": "172.18.10.23", "server3": "172.18.10.24",
+		}, "required,dive,keys,required,endkeys,required,ip"),
+		varScenario(validate, "failed", map[string]string{
+			"user1": "user1", "user2": "user2@gmail.com", "user3": "", "a": "reo@gmail.com",
+		}, "required,dive,keys,required,min=3,endkeys,required,email,min=12"),
+	})
+}
+
+// BenchmarkAliasTag sama dengan TestAliasTag
+func BenchmarkAliasTag(b *testing.B) {
+	validate := newBenchmarkValidator(b)
+
+	runBenchmarks(b, []benchmarkScenario{
+		varScenario(validate, "success", "reoshby1299@gmail.com", validation.AliasAppEmail),
+		varScenario(validate, "failed", "reoo", validation.AliasAppEmail),
+	})
+}
+
+// BenchmarkCustomValidation sama dengan TestCustomValidation
+func BenchmarkCustomValidation(b *testing.B) {
+	validate := newBenchmarkValidator(b)
+
+	runBenchmarks(b, []benchmarkScenario{
+		varScenario(validate, "success", "gadget", validation.TagCategory),
+		varScenario(validate, "failed", "abcd", validation.TagCategory),
+	})
+}
+
+// BenchmarkCustomValidationParameter sama dengan TestCustomValidationParameter
+func BenchmarkCustomValidationParameter(b *testing.B) {
+	validate := newBenchmarkValidator(b)
+
+	runBenchmarks(b, []benchmarkScenario{
+		varScenario(validate, "success", []string{"a", "b", "c"}, "min_category=2"),
+		varScenario(validate, "failed", []string{"r", "e", "o"}, "min_category=2"),
+		varScenario(validate, "invalid param", []string{"a", "b", "c"}, "min_category=x"),
+	})
+}
+
+// BenchmarkCustomMessageValidation sama dengan TestCustomMessageValidation
+func BenchmarkCustomMessageValidation(b *testing.B) {
+	validate := newBenchmarkValidator(b)
+	catalog := validation.NewCatalog()
+
+	runBenchmarks(b, []benchmarkScenario{
+		{Name: "messages", Run: func(ctx context.Context) error {
+			_ = catalog.Messages(validate.VarCtx(ctx, "mafale", validation.TagGender))
+			return nil
+		}},
+	})
+}
+
+// BenchmarkLargeSlice untuk dive pada slice berisi 10k struct, semua valid atau semua gagal
+func BenchmarkLargeSlice(b *testing.B) {
+	validate := newBenchmarkValidator(b)
+
+	valid := &model.Member{Name: "reo", Addresses: make([]model.MemberAddress, benchmarkSize)}
+	invalid := &model.Member{Name: "reo", Addresses: make([]model.MemberAddress, benchmarkSize)}
+	for i := range valid.Addresses {
+		valid.Addresses[i] = model.MemberAddress{City: "Jakarta", Country: "ID"}
+		invalid.Addresses[i] = model.MemberAddress{City: "J", Country: "I"}
+	}
+
+	runBenchmarks(b, []benchmarkScenario{
+		structScenario(validate, "success", valid),
+		structScenario(validate, "failed", invalid),
+		{Name: "generated success", Run: valid.Validate},
+		{Name: "generated failed", Run: invalid.Validate},
+	})
+}
+
+// BenchmarkLargeBasicSlice untuk dive pada slice berisi 10k string
+func BenchmarkLargeBasicSlice(b *testing.B) {
+	validate := newBenchmarkValidator(b)
+
+	server := &model.Server{Name: "db", IPAddresses: make([]string, benchmarkSize)}
+	for i := range server.IPAddresses {
+		server.IPAddresses[i] = fmt.Sprintf("172.18.%d.%d", i/256, i%256)
+	}
+
+	runBenchmarks(b, []benchmarkScenario{
+		structScenario(validate, "success", server),
+		{Name: "generated success", Run: server.Validate},
+	})
+}
+
+// BenchmarkLargeMap untuk dive dengan keys dan endkeys pada map berisi 10k item
+func BenchmarkLargeMap(b *testing.B) {
+	validate := newBenchmarkValidator(b)
+
+	contacts := &model.Contacts{Emails: make(map[string]string, benchmarkSize)}
+	for i := 0; i < benchmarkSize; i++ {
+		contacts.Emails[fmt.Sprintf("user%d", i)] = fmt.Sprintf("user%d@gmail.com", i)
+	}
+
+	runBenchmarks(b, []benchmarkScenario{
+		structScenario(validate, "success", contacts),
+		{Name: "generated success", Run: contacts.Validate},
+	})
+}
+
+// BenchmarkDeepNesting untuk struct bersarang sedalam benchmarkDepth
+func BenchmarkDeepNesting(b *testing.B) {
+	validate := newBenchmarkValidator(b)
+
+	var valid, invalid *BenchmarkNode
+	for i := 0; i < benchmarkDepth; i++ {
+		valid = &BenchmarkNode{Name: "node", Child: valid}
+		invalid = &BenchmarkNode{Name: "n", Child: invalid}
+	}
+
+	runBenchmarks(b, []benchmarkScenario{
+		structScenario(validate, "success", valid),
+		structScenario(validate, "failed", invalid),
+	})
+}
@@ -0,0 +1,158 @@
+package test
+
+import (
+	"context"
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"go-validation/validation"
+	"reflect"
+	"testing"
+)
+
+// TestCodeStable untuk memastikan error code yang sudah dipakai client tidak berubah
+// jika test ini gagal, jangan ubah expected code nya, tambahkan code baru saja
+func TestCodeStable(t *testing.T) {
+	expectCodes := map[string]string{
+		"required":     "FIELD_REQUIRED",
+		"min.string":   "FIELD_TOO_SHORT",
+		"min.items":    "TOO_FEW_ITEMS",
+		"min.number":   "VALUE_TOO_SMALL",
+		"max.string":   "FIELD_TOO_LONG",
+		"max.items":    "TOO_MANY_ITEMS",
+		"max.number":   "VALUE_TOO_LARGE",
+		"len.string":   "INVALID_LENGTH",
+		"len.items":    "INVALID_ITEM_COUNT",
+		"len.number":   "VALUE_NOT_EQUAL",
+		"eq":           "VALUE_NOT_EQUAL",
+		"ne":           "VALUE_NOT_ALLOWED",
+		"gt":           "VALUE_TOO_SMALL",
+		"gte":          "VALUE_TOO_SMALL",
+		"lt":           "VALUE_TOO_LARGE",
+		"lte":          "VALUE_TOO_LARGE",
+		"eqfield":      "FIELD_MISMATCH",
+		"nefield":      "FIELD_MUST_DIFFER",
+		"email":        "INVALID_EMAIL",
+		"ip":           "INVALID_IP_ADDRESS",
+		"ipv4":         "INVALID_IP_ADDRESS",
+		"ipv6":         "INVALID_IP_ADDRESS",
+		"url":          "INVALID_URL",
+		"uuid":         "INVALID_UUID",
+		"alpha":        "INVALID_ALPHA",
+		"alphanum":     "INVALID_ALPHANUMERIC",
+		"numeric":      "INVALID_NUMERIC",
+		"oneof":        "VALUE_NOT_ALLOWED",
+		"category":     "INVALID_CATEGORY",
+		"min_category": "INVALID_CATEGORY_LIST",
+		"gender":       "INVALID_GENDER",
+		"app_email":    "INVALID_EMAIL",
+	}
+
+	codes := validation.Codes()
+	for tag, code := range expectCodes {
+		assert.Equal(t, code, codes[tag], "code for tag %s has changed", tag)
+	}
+}
+
+// TestCodeRegisteredTag untuk memastikan setiap tag yang punya message juga punya error code
+func TestCodeRegisteredTag(t *testing.T) {
+	for _, tag := range validation.NewCatalog().Tags() {
+		for _, kind := range []reflect.Kind{reflect.String, reflect.Slice, reflect.Int} {
+			_, ok := validation.TagCode(tag, kind)
+			assert.True(t, ok, "tag %s with kind %s has no error code", tag, kind)
+		}
+	}
+}
+
+// TestCode untuk mengambil error code dari error field sesuai tag dan kind
+func TestCode(t *testing.T) {
+	validate, err := validation.New(validation.WithValidation("even", func(fl validator.FieldLevel) bool {
+		return fl.Field().Int()%2 == 0
+	}))
+	assert.Nil(t, err)
+
+	scenario := []struct {
+		Name       string
+		Input      any
+		Tag        string
+		ExpectCode string
+	}{
+		{
+			Name:       "test code min string",
+			Input:      "re",
+			Tag:        "required,min=3",
+			ExpectCode: "FIELD_TOO_SHORT",
+		},
+		{
+			Name:       "test code min number",
+			Input:      10,
+			Tag:        "min=17",
+			ExpectCode: "VALUE_TOO_SMALL",
+		},
+		{
+			Name:       "test code min items",
+			Input:      []string{"a"},
+			Tag:        "min=2",
+			ExpectCode: "TOO_FEW_ITEMS",
+		},
+		{
+			Name:       "test code custom tag",
+			Input:      "mafale",
+			Tag:        "gender",
+			ExpectCode: "INVALID_GENDER",
+		},
+		{
+			Name:       "test code alias",
+			Input:      "reoo",
+			Tag:        "app_email",
+			ExpectCode: "INVALID_EMAIL",
+		},
+		{
+			Name:       "test code gt string",
+			Input:      "reo",
+			Tag:        "gt=3",
+			ExpectCode: "FIELD_TOO_SHORT",
+		},
+		{
+			Name:       "test code lte items",
+			Input:      []string{"a", "b", "c"},
+			Tag:        "lte=2",
+			ExpectCode: "TOO_MANY_ITEMS",
+		},
+		{
+			Name:       "test code tag bawaan validator",
+			Input:      "abc",
+			Tag:        "uppercase",
+			ExpectCode: "INVALID_CASE",
+		},
+		{
+			Name:       "test code tag without code",
+			Input:      3,
+			Tag:        "even",
+			ExpectCode: validation.CodeInvalid,
+		},
+	}
+
+	for _, testScenario := range scenario {
+		t.Run(testScenario.Name, func(t *testing.T) {
+			err := validate.VarCtx(context.Background(), testScenario.Input, testScenario.Tag)
+			assert.NotNil(t, err)
+
+			assert.Equal(t, testScenario.ExpectCode, validation.Code(err.(validator.ValidationErrors)[0]))
+		})
+	}
+}
+
+// TestRegisterCode untuk mendaftarkan error code dari custom tag
+// code yang sudah terdaftar tidak bisa diganti dan tidak bisa dihapus, jadi tag register_code_odd
+// hanya dipakai di test ini supaya TestCode tetap lolos saat test diulang atau diacak
+func TestRegisterCode(t *testing.T) {
+	assert.Nil(t, validation.RegisterCode("register_code_odd", "VALUE_NOT_ODD"))
+	assert.Nil(t, validation.RegisterCode("register_code_odd", "VALUE_NOT_ODD"))
+	assert.NotNil(t, validation.RegisterCode("register_code_odd", "NOT_ODD"))
+	assert.NotNil(t, validation.RegisterCode("gender", "INVALID_SEX"))
+	assert.NotNil(t, validation.RegisterCode("odd", ""))
+
+	code, ok := validation.TagCode("register_code_odd", reflect.Int)
+	assert.True(t, ok)
+	assert.Equal(t, "VALUE_NOT_ODD", code)
+}
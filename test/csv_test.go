@@ -0,0 +1,289 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"github.com/stretchr/testify/assert"
+	"go-validation/validation"
+	"strings"
+	"testing"
+	"time"
+)
+
+// CSVCustomer adalah satu baris dari file CSV customer
+type CSVCustomer struct {
+	Nama     string        `csv:"nama" validate:"required,min=2"`
+	Email    string        `csv:"email" validate:"required,email"`
+	Umur     int           `csv:"umur" validate:"gte=17"`
+	Aktif    *bool         `csv:"aktif" validate:"required"`
+	Gender   string        `csv:"gender" validate:"omitempty,gender"`
+	Daftar   time.Time     `csv:"daftar"`
+	Timeout  time.Duration `csv:"timeout"`
+	Catatan  string        `csv:"-"`
+	Internal string
+}
+
+// csvCustomers adalah isi file CSV dengan header, kolom yang tidak dipakai dan urutan kolom yang berbeda dari struct
+const csvCustomers = `email,nama,umur,aktif,gender,daftar,timeout,kota
+reo@gmail.com,reo,20,true,male,2024-01-02T00:00:00Z,1s,Jakarta
+reo,r,16,,,,,Bandung
+"budi@gmail.com","budi
+sahab",umur,ya,laki,kemarin,,Surabaya
+joko@gmail.com,joko,30,false,,,,Medan
+`
+
+// TestCSVValidator untuk validasi file CSV per baris dengan nomor baris dan nama kolom
+func TestCSVValidator(t *testing.T) {
+	validate, err := validation.New(validation.WithFieldNameTag("csv"))
+	assert.Nil(t, err)
+
+	scenario := []struct {
+		Name          string
+		Input         string
+		MaxErrors     int
+		SkipValid     bool
+		ExpectRows    []int
+		ExpectIssues  []validation.CSVIssue
+		ExpectSummary validation.StreamSummary
+	}{
+		{
+			Name:       "test csv semua baris",
+			Input:      csvCustomers,
+			ExpectRows: []int{2, 3, 4, 6},
+			ExpectIssues: []validation.CSVIssue{
+				{Row: 3, Column: "nama", Tag: "min", Code: "FIELD_TOO_SHORT", Message: "nama must be at least 2 characters"},
+				{Row: 3, Column: "email", Tag: "email", Code: "INVALID_EMAIL", Message: "email must be a valid email address, got 'reo'"},
+				{Row: 3, Column: "umur", Tag: "gte", Code: "VALUE_TOO_SMALL", Message: "umur must be greater than or equal to 17"},
+				{Row: 3, Column: "aktif", Tag: "required", Code: "FIELD_REQUIRED", Message: "aktif is required"},
+				{Row: 4, Column: "umur", Tag: "type", Code: "INVALID_TYPE", Message: "umur must be a valid integer, got 'umur'"},
+				{Row: 4, Column: "aktif", Tag: "type", Code: "INVALID_TYPE", Message: "aktif must be a valid boolean, got 'ya'"},
+				{Row: 4, Column: "daftar", Tag: "type", Code: "INVALID_TYPE", Message: "daftar must be a valid time.Time, got 'kemarin'"},
+				{Row: 4, Column: "gender", Tag: "gender", Code: "INVALID_GENDER", Message: "gender must be one of [male female], got 'laki'"},
+			},
+			ExpectSummary: validation.StreamSummary{Records: 4, Invalid: 2},
+		},
+		{
+			Name:       "test csv hanya baris tidak valid",
+			Input:      csvCustomers,
+			SkipValid:  true,
+			ExpectRows: []int{3, 4},
+			ExpectIssues: []validation.CSVIssue{
+				{Row: 3, Column: "nama", Tag: "min", Code: "FIELD_TOO_SHORT", Message: "nama must be at least 2 characters"},
+				{Row: 3, Column: "email", Tag: "email", Code: "INVALID_EMAIL", Message: "email must be a valid email address, got 'reo'"},
+				{Row: 3, Column: "umur", Tag: "gte", Code: "VALUE_TOO_SMALL", Message: "umur must be greater than or equal to 17"},
+				{Row: 3, Column: "aktif", Tag: "required", Code: "FIELD_REQUIRED", Message: "aktif is required"},
+				{Row: 4, Column: "umur", Tag: "type", Code: "INVALID_TYPE", Message: "umur must be a valid integer, got 'umur'"},
+				{Row: 4, Column: "aktif", Tag: "type", Code: "INVALID_TYPE", Message: "aktif must be a valid boolean, got 'ya'"},
+				{Row: 4, Column: "daftar", Tag: "type", Code: "INVALID_TYPE", Message: "daftar must be a valid time.Time, got 'kemarin'"},
+				{Row: 4, Column: "gender", Tag: "gender", Code: "INVALID_GENDER", Message: "gender must be one of [male female], got 'laki'"},
+			},
+			ExpectSummary: validation.StreamSummary{Records: 4, Invalid: 2},
+		},
+		{
+			Name:       "test csv max errors",
+			Input:      csvCustomers,
+			MaxErrors:  1,
+			ExpectRows: []int{2, 3},
+			ExpectIssues: []validation.CSVIssue{
+				{Row: 3, Column: "nama", Tag: "min", Code: "FIELD_TOO_SHORT", Message: "nama must be at least 2 characters"},
+				{Row: 3, Column: "email", Tag: "email", Code: "INVALID_EMAIL", Message: "email must be a valid email address, got 'reo'"},
+				{Row: 3, Column: "umur", Tag: "gte", Code: "VALUE_TOO_SMALL", Message: "umur must be greater than or equal to 17"},
+				{Row: 3, Column: "aktif", Tag: "required", Code: "FIELD_REQUIRED", Message: "aktif is required"},
+			},
+			ExpectSummary: validation.StreamSummary{Records: 2, Invalid: 1, Truncated: true},
+		},
+		{
+			Name:       "test csv jumlah kolom berbeda",
+			Input:      "nama,email,umur,aktif,gender,daftar,timeout\nreo,reo@gmail.com\nbudi,budi@gmail.com,20,true,,,,Jakarta\njoko,joko@gmail.com,30,true,,,\n",
+			SkipValid:  true,
+			ExpectRows: []int{2, 3},
+			ExpectIssues: []validation.CSVIssue{
+				{Row: 2, Tag: "column_count", Code: "INVALID_COLUMN_COUNT", Message: "row must have 7 columns, got 2"},
+				{Row: 3, Tag: "column_count", Code: "INVALID_COLUMN_COUNT", Message: "row must have 7 columns, got 8"},
+			},
+			ExpectSummary: validation.StreamSummary{Records: 3, Invalid: 2},
+		},
+		{
+			Name:          "test csv hanya header",
+			Input:         "\ufeffnama, email ,umur,aktif,gender,daftar,timeout\n",
+			ExpectSummary: validation.StreamSummary{},
+		},
+		{
+			Name:          "test csv kosong",
+			Input:         "",
+			ExpectSummary: validation.StreamSummary{},
+		},
+	}
+
+	for _, testScenario := range scenario {
+		t.Run(testScenario.Name, func(t *testing.T) {
+			csvValidator := validation.NewCSVValidator[CSVCustomer](validate)
+			csvValidator.MaxErrors = testScenario.MaxErrors
+			csvValidator.SkipValid = testScenario.SkipValid
+
+			var rows []int
+			var issues []validation.CSVIssue
+			summary, err := csvValidator.Run(context.Background(), strings.NewReader(testScenario.Input), func(row validation.CSVRow[CSVCustomer]) error {
+				rows = append(rows, row.Row)
+				issues = append(issues, row.Issues...)
+				return nil
+			})
+
+			assert.Nil(t, err)
+			assert.Equal(t, testScenario.ExpectRows, rows)
+			assert.Equal(t, testScenario.ExpectIssues, issues)
+			assert.Equal(t, testScenario.ExpectSummary, summary)
+		})
+	}
+}
+
+// TestCSVValidatorValue untuk isi kolom diubah ke type field
+func TestCSVValidatorValue(t *testing.T) {
+	validate, err := validation.New()
+	assert.Nil(t, err)
+
+	var customers []CSVCustomer
+	_, err = validation.NewCSVValidator[CSVCustomer](validate).Run(context.Background(), strings.NewReader(csvCustomers), func(row validation.CSVRow[CSVCustomer]) error {
+		customers = append(customers, row.Value)
+		return nil
+	})
+	assert.Nil(t, err)
+
+	aktif := true
+	assert.Equal(t, CSVCustomer{
+		Nama:    "reo",
+		Email:   "reo@gmail.com",
+		Umur:    20,
+		Aktif:   &aktif,
+		Gender:  "male",
+		Daftar:  time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+		Timeout: time.Second,
+	}, customers[0])
+	assert.Equal(t, "budi\nsahab", customers[2].Nama)
+	assert.Nil(t, customers[1].Aktif)
+}
+
+// TestCSVValidatorReport untuk report dalam format csv, json dan text
+func TestCSVValidatorReport(t *testing.T) {
+	validate, err := validation.New(validation.WithFieldNameTag("csv"))
+	assert.Nil(t, err)
+
+	input := `nama,email,umur,aktif,gender,daftar,timeout
+reo,"reo, jakarta",20,true,,,
+budi,budi@gmail.com,20,true,,,
+`
+
+	scenario := []struct {
+		Name         string
+		Format       validation.ReportFormat
+		Input        string
+		ExpectOutput string
+	}{
+		{
+			Name:   "test report csv",
+			Format: validation.ReportCSV,
+			Input:  input,
+			ExpectOutput: "row,column,tag,code,message\n" +
+				"2,email,email,INVALID_EMAIL,\"email must be a valid email address, got 'reo, jakarta'\"\n",
+		},
+		{
+			Name:   "test report json",
+			Format: validation.ReportJSON,
+			Input:  input,
+			ExpectOutput: "[\n" +
+				"  {\"row\":2,\"column\":\"email\",\"tag\":\"email\",\"code\":\"INVALID_EMAIL\",\"message\":\"email must be a valid email address, got 'reo, jakarta'\"}\n" +
+				"]\n",
+		},
+		{
+			Name:         "test report text",
+			Format:       validation.ReportText,
+			Input:        input,
+			ExpectOutput: "row 2, column email (email): email must be a valid email address, got 'reo, jakarta' [INVALID_EMAIL]\n",
+		},
+		{
+			Name:         "test report text jumlah kolom berbeda",
+			Format:       validation.ReportText,
+			Input:        "nama,email,umur,aktif,gender,daftar,timeout\nreo,reo@gmail.com\n",
+			ExpectOutput: "row 2 (column_count): row must have 7 columns, got 2 [INVALID_COLUMN_COUNT]\n",
+		},
+		{
+			Name:         "test report json tanpa issue",
+			Format:       validation.ReportJSON,
+			Input:        "nama,email,umur,aktif,gender,daftar,timeout\n",
+			ExpectOutput: "[]\n",
+		},
+	}
+
+	for _, testScenario := range scenario {
+		t.Run(testScenario.Name, func(t *testing.T) {
+			var output bytes.Buffer
+			report, err := validation.NewReportWriter(&output, testScenario.Format)
+			assert.Nil(t, err)
+
+			summary, err := validation.NewCSVValidator[CSVCustomer](validate).Report(context.Background(), strings.NewReader(testScenario.Input), report)
+			assert.Nil(t, err)
+			assert.Equal(t, testScenario.ExpectOutput, output.String())
+			assert.Equal(t, summary.Invalid, strings.Count(output.String(), "INVALID_"))
+		})
+	}
+
+	_, err = validation.NewReportWriter(&bytes.Buffer{}, "xml")
+	assert.EqualError(t, err, `unknown report format "xml"`)
+}
+
+// TestCSVValidatorLocale untuk message report sesuai locale catalog
+func TestCSVValidatorLocale(t *testing.T) {
+	validate, err := validation.New(validation.WithFieldNameTag("csv"))
+	assert.Nil(t, err)
+
+	csvValidator := validation.NewCSVValidator[CSVCustomer](validate)
+	csvValidator.Locale = validation.LocaleIndonesian
+
+	var output bytes.Buffer
+	report, err := validation.NewReportWriter(&output, validation.ReportText)
+	assert.Nil(t, err)
+
+	_, err = csvValidator.Report(context.Background(), strings.NewReader("nama,email,umur,aktif,gender,daftar,timeout\nreo,reo@gmail.com,x,true,,,\n"), report)
+	assert.Nil(t, err)
+	assert.Equal(t, "row 2, column umur (type): umur harus berupa integer yang valid, bukan 'x' [INVALID_TYPE]\n", output.String())
+}
+
+// TestCSVValidatorError untuk file CSV yang tidak bisa divalidasi
+func TestCSVValidatorError(t *testing.T) {
+	validate, err := validation.New()
+	assert.Nil(t, err)
+
+	scenario := []struct {
+		Name        string
+		Input       string
+		ExpectError string
+	}{
+		{
+			Name:        "test csv kolom tidak ada di header",
+			Input:       "nama,email\nreo,reo@gmail.com\n",
+			ExpectError: `csv: missing column "umur"`,
+		},
+		{
+			Name:        "test csv quote rusak",
+			Input:       "nama,email,umur,aktif,gender,daftar,timeout\n\"reo,reo@gmail.com,20,true,,,\n",
+			ExpectError: `parse error on line 2, column 31: extraneous or missing " in quoted-field`,
+		},
+	}
+
+	for _, testScenario := range scenario {
+		t.Run(testScenario.Name, func(t *testing.T) {
+			var output bytes.Buffer
+			report, err := validation.NewReportWriter(&output, validation.ReportJSON)
+			assert.Nil(t, err)
+
+			_, err = validation.NewCSVValidator[CSVCustomer](validate).Report(context.Background(), strings.NewReader(testScenario.Input), report)
+			assert.EqualError(t, err, testScenario.ExpectError)
+			assert.Equal(t, "[]\n", output.String())
+		})
+	}
+
+	_, err = validation.NewCSVValidator[struct {
+		Alamat []string `csv:"alamat"`
+	}](validate).Run(context.Background(), strings.NewReader("alamat\n"), nil)
+	assert.EqualError(t, err, `csv: column "alamat": unsupported type []string`)
+}
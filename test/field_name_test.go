@@ -0,0 +1,110 @@
+package test
+
+import (
+	"context"
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"go-validation/validation"
+	"testing"
+)
+
+// TestFieldNameTag untuk memakai nama dari tag json sebagai nama field di error
+// contoh : validation.New(validation.WithFieldNameTag("json"))
+func TestFieldNameTag(t *testing.T) {
+	validate, err := validation.New(validation.WithFieldNameTag("json"))
+	assert.Nil(t, err)
+
+	type Address struct {
+		City    string `json:"city,omitempty" validate:"required,min=2"`
+		Country string `json:"-" validate:"required"`
+		ZipCode string `json:",omitempty" validate:"required"`
+	}
+
+	type User struct {
+		Name      string    `json:"name,omitempty" validate:"required"`
+		Addresses []Address `json:"addresses,omitempty" validate:"required,dive"`
+		Emails    []string  `json:"emails" validate:"dive,email"`
+	}
+
+	input := User{
+		Addresses: []Address{{City: "Jakarta", Country: "Indonesia", ZipCode: "12345"}, {}},
+		Emails:    []string{"reo"},
+	}
+
+	expectFields := []string{"name", "city", "Country", "ZipCode", "emails[0]"}
+	expectPaths := []string{"name", "addresses[1].city", "addresses[1].Country", "addresses[1].ZipCode", "emails[0]"}
+
+	err = validate.StructCtx(context.Background(), input)
+	assert.NotNil(t, err)
+
+	var fields, paths []string
+	for _, errorField := range err.(validator.ValidationErrors) {
+		fields = append(fields, errorField.Field())
+		paths = append(paths, validation.Path(errorField))
+	}
+
+	assert.Equal(t, expectFields, fields)
+	assert.Equal(t, expectPaths, paths)
+}
+
+// TestFieldNameTagFallback untuk memakai tag lain jika tag json tidak ada
+func TestFieldNameTagFallback(t *testing.T) {
+	validate, err := validation.New(validation.WithFieldNameTag("json", "form", "yaml", "query"))
+	assert.Nil(t, err)
+
+	type SearchRequest struct {
+		Keyword string `form:"keyword" validate:"required"`
+		Page    int    `json:"-" query:"page" validate:"min=1"`
+		Limit   int    `yaml:"limit" validate:"max=100"`
+		Sort    string `validate:"required"`
+	}
+
+	scenario := []struct {
+		Name        string
+		Input       SearchRequest
+		ExpectPaths []string
+	}{
+		{
+			Name:        "test field name fallback failed",
+			Input:       SearchRequest{Limit: 200},
+			ExpectPaths: []string{"keyword", "page", "limit", "Sort"},
+		},
+		{
+			Name:  "test field name fallback success",
+			Input: SearchRequest{Keyword: "validator", Page: 1, Limit: 10, Sort: "name"},
+		},
+	}
+
+	for _, testScenario := range scenario {
+		t.Run(testScenario.Name, func(t *testing.T) {
+			err := validate.StructCtx(context.Background(), testScenario.Input)
+
+			var paths []string
+			if err != nil {
+				for _, errorField := range err.(validator.ValidationErrors) {
+					paths = append(paths, validation.Path(errorField))
+				}
+			}
+
+			assert.Equal(t, testScenario.ExpectPaths, paths)
+		})
+	}
+}
+
+// TestPathVariable untuk path dari validasi variabel map dan slice
+func TestPathVariable(t *testing.T) {
+	validate, err := validation.New(validation.WithFieldNameTag())
+	assert.Nil(t, err)
+
+	type School struct {
+		Name string `json:"name" validate:"required,min=2"`
+	}
+
+	err = validate.VarCtx(context.Background(), map[string]*School{"sd": {Name: "a"}}, "dive,keys,min=2,endkeys,required")
+	assert.NotNil(t, err)
+	assert.Equal(t, "[sd].name", validation.Path(err.(validator.ValidationErrors)[0]))
+
+	err = validate.VarCtx(context.Background(), []string{"172.www"}, "dive,ip")
+	assert.NotNil(t, err)
+	assert.Equal(t, "[0]", validation.Path(err.(validator.ValidationErrors)[0]))
+}
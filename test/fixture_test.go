@@ -0,0 +1,70 @@
+package test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"go-validation/validation"
+	"go-validation/validationtest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+type FixtureLoginRequest struct {
+	Username string `json:"username,omitempty" validate:"required,email"`
+	Password string `json:"password,omitempty" validate:"required,min=6"`
+}
+
+// fixtureTypes adalah type yang bisa dipakai di field type pada file fixture
+var fixtureTypes = validationtest.FixtureTypes{
+	"LoginRequest": validationtest.TypeOf[FixtureLoginRequest](),
+	"EmailMap":     validationtest.TypeOf[map[string]string](),
+}
+
+// TestFixtures untuk menjalankan skenario validasi dari file di testdata
+// QA bisa menambahkan skenario baru di file YAML atau JSON tanpa menulis kode Go
+func TestFixtures(t *testing.T) {
+	validate, err := validation.New()
+	assert.Nil(t, err)
+
+	validationtest.RunFixtures(t, validate, "testdata/*.yaml", fixtureTypes)
+	validationtest.RunFixtures(t, validate, "testdata/*.json", fixtureTypes)
+}
+
+// TestLoadFixtures untuk mengecek lokasi baris setiap fixture dan error pada file yang tidak valid
+func TestLoadFixtures(t *testing.T) {
+	fixtures, err := validationtest.LoadFixtures("testdata/basic_map.yaml")
+	assert.Nil(t, err)
+	assert.Len(t, fixtures, 3)
+	assert.Equal(t, 2, fixtures[0].Line)
+	assert.Equal(t, "testdata/basic_map.yaml", fixtures[0].File)
+
+	dir := t.TempDir()
+
+	scenario := []struct {
+		Name        string
+		Content     string
+		ExpectError string
+	}{
+		{
+			Name:        "test fixture bukan list",
+			Content:     "name: test\ntag: required\n",
+			ExpectError: "invalid.yaml:1: fixture file must contain a list of fixtures",
+		},
+		{
+			Name:        "test fixture tanpa type dan tag",
+			Content:     "- name: test ok\n  tag: required\n- name: test tanpa tag\n  input: reo\n",
+			ExpectError: `invalid.yaml:3: fixture "test tanpa tag" must have a type or a tag`,
+		},
+	}
+
+	for _, testScenario := range scenario {
+		t.Run(testScenario.Name, func(t *testing.T) {
+			path := filepath.Join(dir, "invalid.yaml")
+			assert.Nil(t, os.WriteFile(path, []byte(testScenario.Content), 0o644))
+
+			_, err := validationtest.LoadFixtures(path)
+			assert.NotNil(t, err)
+			assert.Contains(t, err.Error(), testScenario.ExpectError)
+		})
+	}
+}
@@ -0,0 +1,225 @@
+package test
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"go-validation/validation"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type HTTPLoginRequest struct {
+	Username string `json:"username,omitempty" validate:"required,email"`
+	Password string `json:"password,omitempty" validate:"required,min=6"`
+}
+
+// TestHTTPHandler untuk decode dan validasi body request sebelum handler dipanggil
+// jika gagal, handler tidak dipanggil dan response error ditulis dalam JSON
+func TestHTTPHandler(t *testing.T) {
+	validate, err := validation.New(validation.WithFieldNameTag("json"))
+	assert.Nil(t, err)
+
+	handler := validation.Handler(validate, func(w http.ResponseWriter, r *http.Request, request HTTPLoginRequest) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(request.Username))
+	}, validation.WithMaxBodySize(128))
+
+	scenario := []struct {
+		Name           string
+		Body           string
+		AcceptLanguage string
+		ExpectStatus   int
+		ExpectBody     string
+		ExpectResponse validation.ErrorResponse
+	}{
+		{
+			Name:         "test handler success",
+			Body:         `{"username":"reo123@gmail.com","password":"123456"}`,
+			ExpectStatus: http.StatusOK,
+			ExpectBody:   "reo123@gmail.com",
+		},
+		{
+			Name:         "test handler validation failed",
+			Body:         `{"username":"reo","password":"123"}`,
+			ExpectStatus: http.StatusUnprocessableEntity,
+			ExpectResponse: validation.ErrorResponse{
+				Message: "validation failed",
+				Errors: []validation.FieldError{
+					{Field: "username", Tag: "email", Code: "INVALID_EMAIL", Message: "username must be a valid email address, got 'reo'"},
+					{Field: "password", Tag: "min", Code: "FIELD_TOO_SHORT", Param: "6", Message: "password must be at least 6 characters"},
+				},
+			},
+		},
+		{
+			Name:           "test handler validation failed bahasa indonesia",
+			Body:           `{"password":"123456"}`,
+			AcceptLanguage: "fr-FR, id-ID;q=0.8",
+			ExpectStatus:   http.StatusUnprocessableEntity,
+			ExpectResponse: validation.ErrorResponse{
+				Message: "validation failed",
+				Errors: []validation.FieldError{
+					{Field: "username", Tag: "required", Code: "FIELD_REQUIRED", Message: "username wajib diisi"},
+				},
+			},
+		},
+		{
+			Name:         "test handler unknown field",
+			Body:         `{"username":"reo123@gmail.com","password":"123456","role":"admin"}`,
+			ExpectStatus: http.StatusBadRequest,
+			ExpectResponse: validation.ErrorResponse{
+				Message: `invalid request body: json: unknown field "role"`,
+			},
+		},
+		{
+			Name:         "test handler invalid json",
+			Body:         `{"username":`,
+			ExpectStatus: http.StatusBadRequest,
+			ExpectResponse: validation.ErrorResponse{
+				Message: "invalid request body: unexpected EOF",
+			},
+		},
+		{
+			Name:         "test handler multiple json value",
+			Body:         `{"username":"reo123@gmail.com","password":"123456"}{}`,
+			ExpectStatus: http.StatusBadRequest,
+			ExpectResponse: validation.ErrorResponse{
+				Message: "invalid request body: body must only contain a single JSON value",
+			},
+		},
+		{
+			Name:         "test handler body too large",
+			Body:         `{"username":"` + strings.Repeat("a", 200) + `@gmail.com","password":"123456"}`,
+			ExpectStatus: http.StatusRequestEntityTooLarge,
+			ExpectResponse: validation.ErrorResponse{
+				Message: "request body must not be larger than 128 bytes",
+			},
+		},
+	}
+
+	for _, testScenario := range scenario {
+		t.Run(testScenario.Name, func(t *testing.T) {
+			request := httptest.NewRequest(http.MethodPost, "/login", strings.NewReader(testScenario.Body))
+			request.Header.Set("Accept-Language", testScenario.AcceptLanguage)
+			recorder := httptest.NewRecorder()
+
+			handler.ServeHTTP(recorder, request)
+
+			assert.Equal(t, testScenario.ExpectStatus, recorder.Code)
+			if testScenario.ExpectStatus == http.StatusOK {
+				assert.Equal(t, testScenario.ExpectBody, recorder.Body.String())
+				return
+			}
+
+			var response validation.ErrorResponse
+			assert.Nil(t, json.NewDecoder(recorder.Body).Decode(&response))
+			assert.Equal(t, "application/json", recorder.Header().Get("Content-Type"))
+			assert.Equal(t, testScenario.ExpectResponse, response)
+		})
+	}
+}
+
+// TestHTTPMiddleware untuk menyimpan value yang sudah valid di context request
+func TestHTTPMiddleware(t *testing.T) {
+	validate, err := validation.New()
+	assert.Nil(t, err)
+
+	middleware := validation.Middleware[HTTPLoginRequest](validate, validation.WithUnknownFields())
+	handler := middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		request, ok := validation.ValueFromContext[HTTPLoginRequest](r.Context())
+		assert.True(t, ok)
+		_, _ = w.Write([]byte(request.Username))
+	}))
+
+	scenario := []struct {
+		Name         string
+		Body         string
+		ExpectStatus int
+	}{
+		{
+			Name:         "test middleware success with unknown field",
+			Body:         `{"username":"reo123@gmail.com","password":"123456","role":"admin"}`,
+			ExpectStatus: http.StatusOK,
+		},
+		{
+			Name:         "test middleware validation failed",
+			Body:         `{"username":"reo123@gmail.com"}`,
+			ExpectStatus: http.StatusUnprocessableEntity,
+		},
+	}
+
+	for _, testScenario := range scenario {
+		t.Run(testScenario.Name, func(t *testing.T) {
+			request := httptest.NewRequest(http.MethodPost, "/login", strings.NewReader(testScenario.Body))
+			recorder := httptest.NewRecorder()
+
+			handler.ServeHTTP(recorder, request)
+
+			assert.Equal(t, testScenario.ExpectStatus, recorder.Code)
+		})
+	}
+}
+
+// TestDecodeJSONPointer untuk decode body request ke pointer struct
+func TestDecodeJSONPointer(t *testing.T) {
+	validate, err := validation.New()
+	assert.Nil(t, err)
+
+	request := httptest.NewRequest(http.MethodPost, "/login", strings.NewReader(`{"username":"reo123@gmail.com","password":"123456"}`))
+
+	login, err := validation.DecodeJSON[*HTTPLoginRequest](request, validate)
+	assert.Nil(t, err)
+	assert.Equal(t, "reo123@gmail.com", login.Username)
+}
+
+// TestHTTPHandlerNullBody untuk body null atau bukan object JSON ditolak sebagai body tidak valid
+// T berupa pointer ke struct tidak boleh diteruskan ke validator sebagai pointer nil
+func TestHTTPHandlerNullBody(t *testing.T) {
+	validate, err := validation.New(validation.WithFieldNameTag("json"))
+	assert.Nil(t, err)
+
+	scenario := []struct {
+		Name          string
+		Handler       http.Handler
+		Body          string
+		ExpectMessage string
+	}{
+		{
+			Name: "test handler pointer body null",
+			Handler: validation.Handler(validate, func(w http.ResponseWriter, r *http.Request, request *HTTPLoginRequest) {
+				w.WriteHeader(http.StatusOK)
+			}),
+			Body:          " null ",
+			ExpectMessage: "invalid request body: body must be a JSON object",
+		},
+		{
+			Name: "test handler struct body null",
+			Handler: validation.Handler(validate, func(w http.ResponseWriter, r *http.Request, request HTTPLoginRequest) {
+				w.WriteHeader(http.StatusOK)
+			}),
+			Body:          "null",
+			ExpectMessage: "invalid request body: body must be a JSON object",
+		},
+		{
+			Name: "test handler pointer body string",
+			Handler: validation.Handler(validate, func(w http.ResponseWriter, r *http.Request, request *HTTPLoginRequest) {
+				w.WriteHeader(http.StatusOK)
+			}),
+			Body:          `"reo"`,
+			ExpectMessage: "invalid request body: json: cannot unmarshal string into Go value of type test.HTTPLoginRequest",
+		},
+	}
+
+	for _, testScenario := range scenario {
+		t.Run(testScenario.Name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			testScenario.Handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/login", strings.NewReader(testScenario.Body)))
+
+			var response validation.ErrorResponse
+			assert.Nil(t, json.NewDecoder(recorder.Body).Decode(&response))
+			assert.Equal(t, http.StatusBadRequest, recorder.Code)
+			assert.Equal(t, validation.ErrorResponse{Message: testScenario.ExpectMessage}, response)
+		})
+	}
+}
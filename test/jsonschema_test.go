@@ -0,0 +1,246 @@
+package test
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"go-validation/jsonschema"
+	"reflect"
+	"testing"
+)
+
+func generateSchema(t *testing.T, generator *jsonschema.Generator, value any) string {
+	schema, err := generator.Generate(value)
+	assert.Nil(t, err)
+
+	content, err := json.Marshal(schema)
+	assert.Nil(t, err)
+	return string(content)
+}
+
+// TestJSONSchemaStruct untuk membuat JSON Schema dari tag validate pada struct
+// nama property diambil dari tag json
+func TestJSONSchemaStruct(t *testing.T) {
+	type LoginRequest struct {
+		Username string `json:"username,omitempty" validate:"required,email"`
+		Password string `json:"password,omitempty" validate:"required,min=6"`
+	}
+
+	type Server struct {
+		Name        string   `json:"name,omitempty" validate:"required"`
+		IPAddresses []string `json:"ip_addresses,omitempty" validate:"required,dive,ip"`
+	}
+
+	// omitempty membuat validator melewati zero value, jadi schema juga harus menerima zero value
+	type Profile struct {
+		Nickname string   `json:"nickname" validate:"omitempty,min=3"`
+		Status   string   `json:"status" validate:"omitempty,oneof=active inactive"`
+		Age      int      `json:"age" validate:"omitempty,gte=17"`
+		Note     string   `json:"note" validate:"omitempty"`
+		Website  *string  `json:"website" validate:"omitempty,url"`
+		Tags     []string `json:"tags" validate:"omitempty,min=1,dive,omitempty,min=2"`
+	}
+
+	scenario := []struct {
+		Name         string
+		Input        any
+		ExpectSchema string
+	}{
+		{
+			Name:  "test json schema login request",
+			Input: LoginRequest{},
+			ExpectSchema: `{
+				"$schema": "https://json-schema.org/draft/2020-12/schema",
+				"$ref": "#/$defs/LoginRequest",
+				"$defs": {
+					"LoginRequest": {
+						"type": "object",
+						"properties": {
+							"username": {"type": "string", "format": "email", "minLength": 1},
+							"password": {"type": "string", "minLength": 6}
+						},
+						"required": ["username", "password"]
+					}
+				}
+			}`,
+		},
+		{
+			Name:  "test json schema basic slice dive",
+			Input: &Server{},
+			ExpectSchema: `{
+				"$schema": "https://json-schema.org/draft/2020-12/schema",
+				"$ref": "#/$defs/Server",
+				"$defs": {
+					"Server": {
+						"type": "object",
+						"properties": {
+							"name": {"type": "string", "minLength": 1},
+							"ip_addresses": {
+								"type": "array",
+								"items": {"type": "string", "anyOf": [{"format": "ipv4"}, {"format": "ipv6"}]}
+							}
+						},
+						"required": ["name", "ip_addresses"]
+					}
+				}
+			}`,
+		},
+		{
+			Name:  "test json schema omitempty",
+			Input: Profile{},
+			ExpectSchema: `{
+				"$schema": "https://json-schema.org/draft/2020-12/schema",
+				"$ref": "#/$defs/Profile",
+				"$defs": {
+					"Profile": {
+						"type": "object",
+						"properties": {
+							"nickname": {"type": "string", "anyOf": [{"const": ""}, {"minLength": 3}]},
+							"status": {"type": "string", "anyOf": [{"const": ""}, {"enum": ["active", "inactive"]}]},
+							"age": {"type": "integer", "anyOf": [{"const": 0}, {"minimum": 17}]},
+							"note": {"type": "string"},
+							"website": {"type": "string", "format": "uri"},
+							"tags": {
+								"type": "array",
+								"minItems": 1,
+								"items": {"type": "string", "anyOf": [{"const": ""}, {"minLength": 2}]}
+							}
+						}
+					}
+				}
+			}`,
+		},
+	}
+
+	for _, testScenario := range scenario {
+		t.Run(testScenario.Name, func(t *testing.T) {
+			assert.JSONEq(t, testScenario.ExpectSchema, generateSchema(t, jsonschema.NewGenerator(), testScenario.Input))
+		})
+	}
+}
+
+// TestJSONSchemaNested untuk nested struct, slice struct dan map dengan keys/endkeys
+// struct yang dipakai lebih dari sekali hanya dibuat sekali di $defs
+func TestJSONSchemaNested(t *testing.T) {
+	type Address struct {
+		City    string `json:"city,omitempty" validate:"required,min=2"`
+		Country string `json:"country,omitempty" validate:"required,min=2,max=56"`
+	}
+
+	type User struct {
+		Name      string            `json:"name,omitempty" validate:"required"`
+		Age       int               `json:"age" validate:"gte=17,lt=100"`
+		Gender    string            `json:"gender,omitempty" validate:"gender"`
+		Hobby     string            `json:"hobby,omitempty" validate:"category"`
+		Role      string            `json:"role" validate:"oneof=admin member"`
+		Email     string            `json:"email" validate:"app_email"`
+		Address   *Address          `json:"address,omitempty" validate:"required"`
+		Addresses []Address         `json:"addresses,omitempty" validate:"required,min=1,dive"`
+		Emails    map[string]string `json:"emails,omitempty" validate:"required,dive,keys,required,min=3,endkeys,required,email,min=12"`
+		Internal  string            `json:"-" validate:"required"`
+	}
+
+	expectSchema := `{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"$ref": "#/$defs/User",
+		"$defs": {
+			"Address": {
+				"type": "object",
+				"properties": {
+					"city": {"type": "string", "minLength": 2},
+					"country": {"type": "string", "minLength": 2, "maxLength": 56}
+				},
+				"required": ["city", "country"]
+			},
+			"User": {
+				"type": "object",
+				"properties": {
+					"name": {"type": "string", "minLength": 1},
+					"age": {"type": "integer", "minimum": 17, "exclusiveMaximum": 100},
+					"gender": {"type": "string", "enum": ["male", "female"]},
+					"hobby": {"type": "string", "enum": ["hobby", "gadget", "adventure", "automotive"]},
+					"role": {"type": "string", "enum": ["admin", "member"]},
+					"email": {"type": "string", "format": "email", "minLength": 15},
+					"address": {"$ref": "#/$defs/Address"},
+					"addresses": {"type": "array", "items": {"$ref": "#/$defs/Address"}, "minItems": 1},
+					"emails": {
+						"type": "object",
+						"propertyNames": {"type": "string", "minLength": 3},
+						"additionalProperties": {"type": "string", "format": "email", "minLength": 12}
+					}
+				},
+				"required": ["name", "email", "address", "addresses", "emails"]
+			}
+		}
+	}`
+
+	assert.JSONEq(t, expectSchema, generateSchema(t, jsonschema.NewGenerator(), User{}))
+}
+
+// TestJSONSchemaRegister untuk memetakan custom tag ke JSON Schema menggunakan Register
+func TestJSONSchemaRegister(t *testing.T) {
+	type Product struct {
+		SKU   string `json:"sku" validate:"required,sku"`
+		Color string `json:"color" validate:"color"`
+	}
+
+	generator := jsonschema.NewGenerator()
+	generator.Register("sku", jsonschema.Pattern("^[A-Z]{3}-[0-9]{4}$"))
+	generator.Register("color", func(schema *jsonschema.Schema, param string, fieldType reflect.Type) error {
+		schema.Enum = []any{"red", "green", "blue"}
+		schema.Description = "primary color"
+		return nil
+	})
+
+	expectSchema := `{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"$ref": "#/$defs/Product",
+		"$defs": {
+			"Product": {
+				"type": "object",
+				"properties": {
+					"sku": {"type": "string", "minLength": 1, "pattern": "^[A-Z]{3}-[0-9]{4}$"},
+					"color": {"type": "string", "enum": ["red", "green", "blue"], "description": "primary color"}
+				},
+				"required": ["sku"]
+			}
+		}
+	}`
+
+	assert.JSONEq(t, expectSchema, generateSchema(t, generator, Product{}))
+}
+
+// TestJSONSchemaError untuk tag yang tidak bisa diterjemahkan ke JSON Schema
+func TestJSONSchemaError(t *testing.T) {
+	type InvalidParam struct {
+		Password string `json:"password" validate:"min=abc"`
+	}
+
+	type InvalidDive struct {
+		Name string `json:"name" validate:"dive,required"`
+	}
+
+	type InvalidKeys struct {
+		Emails map[string]string `json:"emails" validate:"dive,keys,min=3"`
+	}
+
+	type InvalidType struct {
+		Callback func() `json:"callback"`
+	}
+
+	scenario := []struct {
+		Name  string
+		Input any
+	}{
+		{Name: "test json schema param tidak valid", Input: InvalidParam{}},
+		{Name: "test json schema dive bukan slice", Input: InvalidDive{}},
+		{Name: "test json schema keys tanpa endkeys", Input: InvalidKeys{}},
+		{Name: "test json schema type tidak didukung", Input: InvalidType{}},
+	}
+
+	for _, testScenario := range scenario {
+		t.Run(testScenario.Name, func(t *testing.T) {
+			_, err := jsonschema.NewGenerator().Generate(testScenario.Input)
+			assert.NotNil(t, err)
+		})
+	}
+}
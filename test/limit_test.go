@@ -0,0 +1,264 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"go-validation/validation"
+	"go-validation/validationtest"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+// LimitAddress dan LimitUser sama seperti struct di TestValidasiSlice
+type LimitAddress struct {
+	City    string `json:"city,omitempty" validate:"required,min=2"`
+	Country string `json:"country,omitempty" validate:"required,min=2"`
+}
+
+type LimitUser struct {
+	Name      string         `json:"name,omitempty" validate:"required"`
+	Addresses []LimitAddress `json:"addresses,omitempty" validate:"required,dive"`
+}
+
+// newLimitUser untuk membuat LimitUser dengan address kosong sebanyak size, setiap address punya 2 error
+func newLimitUser(size int) *LimitUser {
+	return &LimitUser{Addresses: make([]LimitAddress, size)}
+}
+
+// TestStructCtxLimit untuk membatasi jumlah error dari validasi struct
+func TestStructCtxLimit(t *testing.T) {
+	validate, err := validation.New()
+	assert.Nil(t, err)
+
+	scenario := []struct {
+		Name          string
+		Options       []validation.StructOption
+		Input         *LimitUser
+		ExpectErrors  []validationtest.Failure
+		ExpectCount   int
+		ExpectOmitted int
+		ExpectStopped bool
+	}{
+		{
+			Name:          "test limit fail fast",
+			Options:       []validation.StructOption{validation.WithFailFast()},
+			Input:         newLimitUser(1000),
+			ExpectErrors:  []validationtest.Failure{{Field: "Name", Tag: "required"}},
+			ExpectCount:   1,
+			ExpectOmitted: 2,
+			ExpectStopped: true,
+		},
+		{
+			Name:          "test limit max errors",
+			Options:       []validation.StructOption{validation.WithMaxErrors(50)},
+			Input:         newLimitUser(1000),
+			ExpectCount:   50,
+			ExpectOmitted: 1,
+			ExpectStopped: true,
+		},
+		{
+			Name:    "test limit max errors per path",
+			Options: []validation.StructOption{validation.WithMaxErrorsPerPath(2)},
+			Input:   newLimitUser(1000),
+			ExpectErrors: []validationtest.Failure{
+				{Field: "Name", Tag: "required"},
+				{Field: "Addresses[0].City", Tag: "required"},
+				{Field: "Addresses[0].Country", Tag: "required"},
+				{Field: "Addresses[1].City", Tag: "required"},
+				{Field: "Addresses[1].Country", Tag: "required"},
+			},
+			ExpectCount:   5,
+			ExpectOmitted: 1996,
+		},
+		{
+			Name:    "test limit max errors dan per path",
+			Options: []validation.StructOption{validation.WithMaxErrorsPerPath(2), validation.WithMaxErrors(3)},
+			Input:   newLimitUser(1000),
+			ExpectErrors: []validationtest.Failure{
+				{Field: "Name", Tag: "required"},
+				{Field: "Addresses[0].City", Tag: "required"},
+				{Field: "Addresses[0].Country", Tag: "required"},
+			},
+			ExpectCount:   3,
+			ExpectOmitted: 2,
+			ExpectStopped: true,
+		},
+		{
+			Name:    "test limit tidak terpotong",
+			Options: []validation.StructOption{validation.WithMaxErrors(10), validation.WithMaxErrorsPerPath(5)},
+			Input:   &LimitUser{Name: "reo", Addresses: []LimitAddress{{City: "J", Country: "ID"}}},
+			ExpectErrors: []validationtest.Failure{
+				{Field: "Addresses[0].City", Tag: "min"},
+			},
+			ExpectCount: 1,
+		},
+		{
+			Name:        "test limit tanpa option",
+			Input:       newLimitUser(1000),
+			ExpectCount: 2001,
+		},
+		{
+			Name:    "test limit success",
+			Options: []validation.StructOption{validation.WithFailFast()},
+			Input:   &LimitUser{Name: "reo", Addresses: []LimitAddress{{City: "Jakarta", Country: "ID"}}},
+		},
+	}
+
+	for _, testScenario := range scenario {
+		t.Run(testScenario.Name, func(t *testing.T) {
+			err := validation.StructCtx(context.Background(), validate, testScenario.Input, testScenario.Options...)
+			if testScenario.ExpectCount == 0 {
+				assert.Nil(t, err)
+				return
+			}
+
+			var validationErrors validator.ValidationErrors
+			assert.True(t, errors.As(err, &validationErrors))
+			assert.Len(t, validationErrors, testScenario.ExpectCount)
+			assert.Equal(t, testScenario.ExpectOmitted, validation.Omitted(err))
+
+			if testScenario.ExpectErrors != nil {
+				assert.Equal(t, testScenario.ExpectErrors, validationtest.Failures(validationErrors))
+			}
+
+			var truncated *validation.TruncatedErrors
+			assert.Equal(t, testScenario.ExpectOmitted > 0, errors.As(err, &truncated))
+			if truncated != nil {
+				assert.Equal(t, testScenario.ExpectStopped, truncated.Stopped)
+			}
+		})
+	}
+}
+
+// TestStructCtxLimitMessage untuk message error yang terpotong berisi jumlah error yang dibuang
+func TestStructCtxLimitMessage(t *testing.T) {
+	validate, err := validation.New()
+	assert.Nil(t, err)
+
+	err = validation.StructCtx(context.Background(), validate, newLimitUser(1000), validation.WithMaxErrors(50))
+	assert.True(t, strings.HasSuffix(err.Error(), "\nand at least 1 more"))
+	assert.Len(t, validation.NewCatalog().Messages(err), 50)
+
+	// tanpa WithMaxErrors semua field diperiksa jadi jumlah error yang dibuang pasti
+	err = validation.StructCtx(context.Background(), validate, newLimitUser(1000), validation.WithMaxErrorsPerPath(2))
+	assert.True(t, strings.HasSuffix(err.Error(), "\nand 1996 more"))
+
+	// error selain error validasi dikembalikan apa adanya
+	var user *LimitUser
+	err = validation.StructCtx(context.Background(), validate, user, validation.WithFailFast())
+	assert.Equal(t, validate.StructCtx(context.Background(), user), err)
+	assert.Equal(t, 0, validation.Omitted(err))
+}
+
+// TestHTTPHandlerMaxErrors untuk response error yang terpotong berisi jumlah error yang dibuang
+func TestHTTPHandlerMaxErrors(t *testing.T) {
+	validate, err := validation.New(validation.WithFieldNameTag("json"))
+	assert.Nil(t, err)
+
+	handler := validation.Handler(validate, func(w http.ResponseWriter, r *http.Request, request LimitUser) {
+		w.WriteHeader(http.StatusOK)
+	}, validation.WithStructOptions(validation.WithMaxErrors(2)))
+
+	body := `{"addresses":[` + strings.Repeat(`{},`, 499) + `{}]}`
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/users", strings.NewReader(body)))
+
+	var response validation.ErrorResponse
+	assert.Nil(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	assert.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+	assert.Equal(t, "validation failed, showing 2 errors and at least 1 more", response.Message)
+	assert.Len(t, response.Errors, 2)
+	assert.Equal(t, 1, response.Omitted)
+}
+
+// TestStructCtxLimitStop untuk memastikan validasi berhenti begitu error melebihi WithMaxErrors
+// tag counted menghitung berapa kali dipanggil, tanpa batas dipanggil untuk semua item
+func TestStructCtxLimitStop(t *testing.T) {
+	var calls atomic.Int64
+	validate, err := validation.New(validation.WithValidation("counted", func(fl validator.FieldLevel) bool {
+		calls.Add(1)
+		return true
+	}))
+	assert.Nil(t, err)
+
+	type CountedItem struct {
+		Code string `validate:"counted,required"`
+	}
+
+	type CountedUser struct {
+		Name  string        `validate:"required"`
+		Items []CountedItem `validate:"required,dive"`
+	}
+
+	user := &CountedUser{Items: make([]CountedItem, 1000)}
+
+	var validationErrors validator.ValidationErrors
+	err = validation.StructCtx(context.Background(), validate, user)
+	assert.True(t, errors.As(err, &validationErrors))
+	assert.Len(t, validationErrors, 1001)
+	assert.Equal(t, int64(1000), calls.Load())
+
+	calls.Store(0)
+	err = validation.StructCtx(context.Background(), validate, user, validation.WithMaxErrors(3))
+	assert.True(t, errors.As(err, &validationErrors))
+	assert.Equal(t, []validationtest.Failure{
+		{Field: "Name", Tag: "required"},
+		{Field: "Items[0].Code", Tag: "required"},
+		{Field: "Items[1].Code", Tag: "required"},
+	}, validationtest.Failures(validationErrors))
+	assert.Equal(t, int64(3), calls.Load())
+
+	calls.Store(0)
+	err = validation.StructCtx(context.Background(), validate, user, validation.WithFailFast())
+	assert.True(t, errors.As(err, &validationErrors))
+	assert.Equal(t, []validationtest.Failure{{Field: "Name", Tag: "required"}}, validationtest.Failures(validationErrors))
+	assert.Equal(t, int64(1), calls.Load())
+}
+
+// LimitRuleUser adalah struct yang tag Emails nya diganti oleh rules dari file dan tag Backups hanya ada di rules
+type LimitRuleUser struct {
+	Name    string   `validate:"required"`
+	Emails  []string `json:"emails" validate:"dive,required"`
+	Backups []string `json:"backups"`
+}
+
+// TestStructCtxLimitRuleSet untuk validasi yang berhenti lebih awal tetap memakai tag dari rules WithRuleSet
+func TestStructCtxLimitRuleSet(t *testing.T) {
+	rules, err := validation.RuleLoader{Types: validation.RuleTypes{"LimitRuleUser": LimitRuleUser{}}}.
+		Parse("rules.yaml", []byte("LimitRuleUser:\n  Emails: dive,email\n  Backups: dive,email\n"))
+	assert.Nil(t, err)
+
+	validate, err := validation.New(validation.WithRuleSet(rules))
+	assert.Nil(t, err)
+
+	user := &LimitRuleUser{Name: "reo", Emails: []string{"x", "y"}, Backups: []string{"z"}}
+	expected := []validationtest.Failure{
+		{Field: "Emails[0]", Tag: "email"},
+		{Field: "Emails[1]", Tag: "email"},
+		{Field: "Backups[0]", Tag: "email"},
+	}
+
+	var validationErrors validator.ValidationErrors
+	assert.True(t, errors.As(validate.StructCtx(context.Background(), user), &validationErrors))
+	assert.Equal(t, expected, validationtest.Failures(validationErrors))
+
+	err = validation.StructCtx(context.Background(), validate, user, validation.WithMaxErrors(5))
+	assert.True(t, errors.As(err, &validationErrors))
+	assert.Equal(t, expected, validationtest.Failures(validationErrors))
+	assert.Equal(t, 0, validation.Omitted(err))
+
+	err = validation.StructCtx(context.Background(), validate, user, validation.WithFailFast())
+	assert.True(t, errors.As(err, &validationErrors))
+	assert.Equal(t, expected[:1], validationtest.Failures(validationErrors))
+	assert.Equal(t, 1, validation.Omitted(err))
+
+	err = validation.StructCtx(context.Background(), validate, &LimitRuleUser{Name: "reo", Backups: []string{"z"}}, validation.WithFailFast())
+	assert.True(t, errors.As(err, &validationErrors))
+	assert.Equal(t, expected[2:], validationtest.Failures(validationErrors))
+}
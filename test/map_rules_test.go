@@ -0,0 +1,164 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"go-validation/validation"
+	"go-validation/validationtest"
+	"testing"
+)
+
+// TestValidateMapRules untuk validasi map[string]any dari JSON tanpa membuat struct
+// setiap key punya tag sendiri, termasuk nested object dan item array
+// rules di dalam object yang tidak ada (misal profile) tidak dijalankan
+func TestValidateMapRules(t *testing.T) {
+	validate, err := validation.New()
+	assert.Nil(t, err)
+
+	rules := validation.Rules{
+		"username":            "required,email",
+		"address":             "required",
+		"address.city":        "required,min=2",
+		"addresses":           "required,min=1",
+		"addresses[].city":    "required",
+		"addresses[].country": "required,min=2",
+		"servers":             "omitempty,dive,ip",
+		"emails":              "omitempty,dive,keys,min=3,endkeys,email",
+		"matrix[][]":          "min=1",
+		"profile":             validation.Rules{"gender": "gender", "hobbies[]": "category"},
+	}
+
+	scenario := []struct {
+		Name         string
+		Input        string
+		ExpectErrors []validationtest.Failure
+	}{
+		{
+			Name: "test validate map failed",
+			Input: `{
+				"username": "reo",
+				"address": {"city": "a"},
+				"addresses": [{"city": "Jakarta", "country": "Indonesia"}, {"country": "I"}],
+				"servers": ["172.18.10.22", "qwert"],
+				"emails": {"ab": "ab@gmail.com", "user1": "user1"},
+				"matrix": [[1, 0]],
+				"profile": {"gender": "mafale", "hobbies": ["gadget", "sleep"]}
+			}`,
+			ExpectErrors: []validationtest.Failure{
+				{Field: "username", Tag: "email"},
+				{Field: "address.city", Tag: "min"},
+				{Field: "addresses[1].city", Tag: "required"},
+				{Field: "addresses[1].country", Tag: "min"},
+				{Field: "servers[1]", Tag: "ip"},
+				{Field: "emails[ab]", Tag: "min"},
+				{Field: "emails[user1]", Tag: "email"},
+				{Field: "matrix[0][1]", Tag: "min"},
+				{Field: "profile.gender", Tag: "gender"},
+				{Field: "profile.hobbies[1]", Tag: "category"},
+			},
+		},
+		{
+			Name:  "test validate map object tidak ada",
+			Input: `{"username": "reo123@gmail.com", "addresses": []}`,
+			ExpectErrors: []validationtest.Failure{
+				{Field: "address", Tag: "required"},
+				{Field: "addresses", Tag: "min"},
+			},
+		},
+		{
+			Name: "test validate map success",
+			Input: `{
+				"username": "reo123@gmail.com",
+				"address": {"city": "Jakarta"},
+				"addresses": [{"city": "Jakarta", "country": "Indonesia"}],
+				"profile": {"gender": "male", "hobbies": ["gadget"]}
+			}`,
+		},
+	}
+
+	for _, testScenario := range scenario {
+		t.Run(testScenario.Name, func(t *testing.T) {
+			var data map[string]any
+			assert.Nil(t, json.Unmarshal([]byte(testScenario.Input), &data))
+
+			err := validation.ValidateMap(context.Background(), validate, data, rules)
+
+			validationtest.AssertFailures(t, err, testScenario.ExpectErrors)
+		})
+	}
+}
+
+// TestValidateMapMessages untuk memastikan error dari map bisa dipakai catalog dan problem details
+func TestValidateMapMessages(t *testing.T) {
+	validate, err := validation.New()
+	assert.Nil(t, err)
+
+	data := map[string]any{
+		"addresses": []any{map[string]any{"city": ""}},
+		"servers":   []any{"qwert"},
+	}
+
+	err = validation.ValidateMap(context.Background(), validate, data, validation.Rules{
+		"addresses[].city": "required",
+		"servers":          "dive,ip",
+	})
+	assert.NotNil(t, err)
+
+	catalog := validation.NewCatalog()
+	assert.Equal(t, []string{
+		"city is required",
+		"servers[0] must be a valid IP address, got 'qwert'",
+	}, catalog.Messages(err))
+
+	fieldError := err.(validator.ValidationErrors)[0]
+	assert.Equal(t, "map.addresses[0].city", fieldError.Namespace())
+	assert.Equal(t, "/addresses/0/city", validation.Pointer(fieldError))
+	assert.Equal(t, "FIELD_REQUIRED", validation.Code(fieldError))
+}
+
+// TestValidateMapSliceOrder untuk error dari dive pada slice yang urut sesuai index, bukan urutan string
+func TestValidateMapSliceOrder(t *testing.T) {
+	validate, err := validation.New()
+	assert.Nil(t, err)
+
+	tags := make([]any, 12)
+	for i := range tags {
+		tags[i] = "qwert"
+	}
+
+	err = validation.ValidateMap(context.Background(), validate, map[string]any{"tags": tags}, validation.Rules{
+		"tags": "dive,ip",
+	})
+
+	var paths []string
+	for _, fieldError := range err.(validator.ValidationErrors) {
+		paths = append(paths, validation.Path(fieldError))
+	}
+
+	assert.Equal(t, []string{
+		"tags[0]", "tags[1]", "tags[2]", "tags[3]", "tags[4]", "tags[5]",
+		"tags[6]", "tags[7]", "tags[8]", "tags[9]", "tags[10]", "tags[11]",
+	}, paths)
+}
+
+// TestCompileRulesError untuk rules yang tidak valid
+func TestCompileRulesError(t *testing.T) {
+	scenario := []struct {
+		Name  string
+		Rules validation.Rules
+	}{
+		{Name: "test rules bukan string", Rules: validation.Rules{"age": 17}},
+		{Name: "test rules path kosong", Rules: validation.Rules{"address..city": "required"}},
+		{Name: "test rules path tanpa nama", Rules: validation.Rules{"[]": "required"}},
+		{Name: "test rules bracket tidak valid", Rules: validation.Rules{"tags[0]": "required"}},
+	}
+
+	for _, testScenario := range scenario {
+		t.Run(testScenario.Name, func(t *testing.T) {
+			_, err := validation.CompileRules(testScenario.Rules)
+			assert.NotNil(t, err)
+		})
+	}
+}
@@ -0,0 +1,238 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"go-validation/validation"
+	"log"
+	"testing"
+)
+
+// TestCatalogMessages untuk mengubah validator.ValidationErrors menjadi list message
+// placeholder {field}, {param} dan {value} diganti sesuai error field
+func TestCatalogMessages(t *testing.T) {
+	validate, err := validation.New()
+	assert.Nil(t, err)
+
+	catalog := validation.NewCatalog()
+
+	type Address struct {
+		City string `json:"city,omitempty" validate:"required,min=2"`
+	}
+
+	type User struct {
+		Email     string    `json:"email,omitempty" validate:"required,email"`
+		Password  string    `json:"password,omitempty" validate:"required,min=6"`
+		Age       int       `json:"age,omitempty" validate:"min=17"`
+		Hobbies   []string  `json:"hobbies,omitempty" validate:"max=2,dive,category"`
+		Servers   []string  `json:"servers,omitempty" validate:"dive,ip"`
+		Addresses []Address `json:"addresses,omitempty" validate:"required,dive"`
+	}
+
+	scenario := []struct {
+		Name           string
+		Input          User
+		ExpectMessages []string
+	}{
+		{
+			Name: "test catalog message failed",
+			Input: User{
+				Email:     "reo",
+				Password:  "123",
+				Age:       10,
+				Hobbies:   []string{"hobby", "gadget", "sleep"},
+				Servers:   []string{"172.www"},
+				Addresses: []Address{{City: "a"}},
+			},
+			ExpectMessages: []string{
+				"Email must be a valid email address, got 'reo'",
+				"Password must be at least 6 characters",
+				"Age must be 17 or greater",
+				"Hobbies must contain at most 2 items",
+				"Servers[0] must be a valid IP address, got '172.www'",
+				"City must be at least 2 characters",
+			},
+		},
+		{
+			Name: "test catalog message dive children",
+			Input: User{
+				Email:     "reo@gmail.com",
+				Password:  "123456",
+				Age:       20,
+				Hobbies:   []string{"sleep"},
+				Addresses: []Address{{}},
+			},
+			ExpectMessages: []string{
+				"Hobbies[0] must be one of [hobby gadget adventure automotive], got 'sleep'",
+				"City is required",
+			},
+		},
+		{
+			Name: "test catalog message success",
+			Input: User{
+				Email:     "reo@gmail.com",
+				Password:  "123456",
+				Age:       20,
+				Addresses: []Address{{City: "Jakarta"}},
+			},
+		},
+	}
+
+	for _, testScenario := range scenario {
+		t.Run(testScenario.Name, func(t *testing.T) {
+			err := validate.StructCtx(context.Background(), testScenario.Input)
+			messages := catalog.Messages(err)
+			for _, message := range messages {
+				log.Println(message)
+			}
+
+			assert.Equal(t, testScenario.ExpectMessages, messages)
+		})
+	}
+}
+
+// TestCatalogRegister untuk mengganti message dari tag yang sudah ada atau menambahkan tag baru
+func TestCatalogRegister(t *testing.T) {
+	validate, err := validation.New()
+	assert.Nil(t, err)
+
+	catalog := validation.NewCatalog()
+	catalog.Register("min.string", "{field} minimal {param} karakter")
+	catalog.Register("required", "{field} wajib diisi")
+
+	scenario := []struct {
+		Name          string
+		Input         string
+		Tag           string
+		ExpectMessage []string
+	}{
+		{
+			Name:          "test register message with kind suffix",
+			Input:         "re",
+			Tag:           "min=3",
+			ExpectMessage: []string{"value minimal 3 karakter"},
+		},
+		{
+			Name:          "test register message override",
+			Input:         "",
+			Tag:           "required",
+			ExpectMessage: []string{"value wajib diisi"},
+		},
+		{
+			Name:          "test message alias",
+			Input:         "reo",
+			Tag:           "app_email",
+			ExpectMessage: []string{"value must be a valid email address, got 'reo'"},
+		},
+		{
+			Name:          "test message alias memakai message dari actual tag",
+			Input:         "reo@mail.com",
+			Tag:           "app_email",
+			ExpectMessage: []string{"value minimal 15 karakter"},
+		},
+		{
+			Name:          "test message tag bawaan validator",
+			Input:         "abc",
+			Tag:           "uppercase",
+			ExpectMessage: []string{"value must be uppercase"},
+		},
+	}
+
+	for _, testScenario := range scenario {
+		t.Run(testScenario.Name, func(t *testing.T) {
+			err := validate.VarCtx(context.Background(), testScenario.Input, testScenario.Tag)
+
+			assert.Equal(t, testScenario.ExpectMessage, catalog.Messages(err))
+		})
+	}
+}
+
+// TestCatalogAliasParam untuk message alias yang mengikuti param dari alias yang sedang aktif
+func TestCatalogAliasParam(t *testing.T) {
+	validate, err := validation.New(validation.WithAlias(validation.AliasAppEmail, "required,email,min=20"))
+	assert.Nil(t, err)
+
+	err = validate.VarCtx(context.Background(), "reoshby@gmail.com", validation.AliasAppEmail)
+	assert.Equal(t, []string{"value must be at least 20 characters"}, validation.NewCatalog().Messages(err))
+}
+
+// TestCatalogMessagesNonValidationError untuk error selain validator.ValidationErrors
+func TestCatalogMessagesNonValidationError(t *testing.T) {
+	catalog := validation.NewCatalog()
+
+	assert.Nil(t, catalog.Messages(nil))
+	assert.Equal(t, []string{"unexpected error"}, catalog.Messages(errors.New("unexpected error")))
+}
+
+// builtInTags adalah semua tag dan alias bawaan validator v10
+var builtInTags = []string{
+	"required", "required_if", "required_unless", "skip_unless", "required_with", "required_with_all",
+	"required_without", "required_without_all", "excluded_if", "excluded_unless", "excluded_with",
+	"excluded_with_all", "excluded_without", "excluded_without_all", "isdefault", "len", "min", "max", "eq",
+	"eq_ignore_case", "ne", "ne_ignore_case", "lt", "lte", "gt", "gte", "eqfield", "eqcsfield", "necsfield",
+	"gtcsfield", "gtecsfield", "ltcsfield", "ltecsfield", "nefield", "gtefield", "gtfield", "ltefield", "ltfield",
+	"fieldcontains", "fieldexcludes", "alpha", "alphanum", "alphaunicode", "alphanumunicode", "boolean",
+	"numeric", "number", "hexadecimal", "hexcolor", "rgb", "rgba", "hsl", "hsla", "e164", "email", "url",
+	"http_url", "uri", "urn_rfc2141", "file", "filepath", "base64", "base64url", "base64rawurl", "contains",
+	"containsany", "containsrune", "excludes", "excludesall", "excludesrune", "startswith", "endswith",
+	"startsnotwith", "endsnotwith", "image", "isbn", "isbn10", "isbn13", "issn", "eth_addr", "eth_addr_checksum",
+	"btc_addr", "btc_addr_bech32", "uuid", "uuid3", "uuid4", "uuid5", "uuid_rfc4122", "uuid3_rfc4122",
+	"uuid4_rfc4122", "uuid5_rfc4122", "ulid", "md4", "md5", "sha256", "sha384", "sha512", "ripemd128",
+	"ripemd160", "tiger128", "tiger160", "tiger192", "ascii", "printascii", "multibyte", "datauri", "latitude",
+	"longitude", "ssn", "ipv4", "ipv6", "ip", "cidrv4", "cidrv6", "cidr", "tcp4_addr", "tcp6_addr", "tcp_addr",
+	"udp4_addr", "udp6_addr", "udp_addr", "ip4_addr", "ip6_addr", "ip_addr", "unix_addr", "mac", "hostname",
+	"hostname_rfc1123", "fqdn", "unique", "oneof", "html", "html_encoded", "url_encoded", "dir", "dirpath",
+	"json", "jwt", "hostname_port", "lowercase", "uppercase", "datetime", "timezone", "iso3166_1_alpha2",
+	"iso3166_1_alpha3", "iso3166_1_alpha_numeric", "iso3166_2", "iso4217", "iso4217_numeric",
+	"bcp47_language_tag", "postcode_iso3166_alpha2", "postcode_iso3166_alpha2_field", "bic", "semver",
+	"dns_rfc1035_label", "credit_card", "cve", "luhn_checksum", "mongodb", "cron", "spicedb", "iscolor",
+	"country_code",
+}
+
+// TestCatalogBuiltInTags untuk memastikan semua tag bawaan validator punya message sendiri di setiap locale
+// tag yang memakai message default dianggap belum punya message
+func TestCatalogBuiltInTags(t *testing.T) {
+	catalog := validation.NewCatalog()
+
+	for _, locale := range []string{validation.LocaleEnglish, validation.LocaleIndonesian} {
+		fallback := catalog.Translate(validation.NewFieldError("Nama", "Nama", "-", "-", "", "reo"), locale)
+
+		for _, tag := range builtInTags {
+			for _, value := range []any{"reo", []string{"reo"}, 3} {
+				message := catalog.Translate(validation.NewFieldError("Nama", "Nama", tag, tag, "1", value), locale)
+				assert.NotEqual(t, fallback, message, "tag %s with value %v has no %s message", tag, value, locale)
+			}
+		}
+	}
+}
+
+// TestCatalogMessagesKind untuk message gt, gte, lt, lte, eq dan ne sesuai kind field
+func TestCatalogMessagesKind(t *testing.T) {
+	validate, err := validation.New()
+	assert.Nil(t, err)
+
+	catalog := validation.NewCatalog()
+
+	scenario := []struct {
+		Name          string
+		Input         any
+		Tag           string
+		ExpectMessage string
+	}{
+		{Name: "test message gte string", Input: "reo", Tag: "gte=5", ExpectMessage: "value must be at least 5 characters"},
+		{Name: "test message gt items", Input: []string{"reo"}, Tag: "gt=1", ExpectMessage: "value must contain more than 1 items"},
+		{Name: "test message lt string", Input: "reo sahobby", Tag: "lt=5", ExpectMessage: "value must be shorter than 5 characters"},
+		{Name: "test message lte items", Input: []int{1, 2, 3}, Tag: "lte=2", ExpectMessage: "value must contain at most 2 items"},
+		{Name: "test message gte number", Input: 3, Tag: "gte=5", ExpectMessage: "value must be greater than or equal to 5"},
+		{Name: "test message eq string", Input: "reo", Tag: "eq=budi", ExpectMessage: "value must be equal to 'budi'"},
+		{Name: "test message ne items", Input: []string{"reo"}, Tag: "ne=1", ExpectMessage: "value must not contain exactly 1 items"},
+	}
+
+	for _, testScenario := range scenario {
+		t.Run(testScenario.Name, func(t *testing.T) {
+			err := validate.VarCtx(context.Background(), testScenario.Input, testScenario.Tag)
+			assert.Equal(t, []string{testScenario.ExpectMessage}, catalog.Messages(err))
+		})
+	}
+}
@@ -0,0 +1,88 @@
+// Package model berisi struct dari skenario validation_struct_test.go yang dibuatkan method Validate oleh validategen
+// skenario validasi variable (Var) dibungkus menjadi field struct dengan tag yang sama
+package model
+
+//go:generate go run go-validation/cmd/validategen -type Customer,LoginRequest,LoginRequet,User,Member,Server,Directory,Contacts,Profile,Credential
+
+// Customer sama dengan TestValidationStruct
+type Customer struct {
+	Nama string `json:"nama,omitempty" validate:"required,min=2"`
+}
+
+// LoginRequest sama dengan TestValidasiStruct
+type LoginRequest struct {
+	Username string `json:"username,omitempty" validate:"required,email"`
+	Password string `json:"password,omitempty" validate:"required,min=6"`
+}
+
+// LoginRequet sama dengan TestValidationErrors
+type LoginRequet struct {
+	Username string `json:"username,omitempty" validate:"required,email,min=3"`
+	Password string `json:"password,omitempty" validate:"required,alpha,min=6"`
+}
+
+// Address sama dengan TestValidasiNestedStruct
+type Address struct {
+	City    string `json:"city,omitempty" validate:"required"`
+	Country string `json:"country,omitempty" validate:"required"`
+}
+
+// User sama dengan TestValidasiNestedStruct
+type User struct {
+	Name    string   `json:"name,omitempty" validate:"required"`
+	Address *Address `json:"address,omitempty" validate:"required"`
+}
+
+// MemberAddress sama dengan Address di TestValidasiSlice
+type MemberAddress struct {
+	City    string `json:"city,omitempty" validate:"required,min=2"`
+	Country string `json:"country,omitempty" validate:"required,min=2"`
+}
+
+// Member sama dengan User di TestValidasiSlice
+type Member struct {
+	Name      string          `json:"name,omitempty" validate:"required"`
+	Addresses []MemberAddress `json:"addresses,omitempty" validate:"required,dive"`
+}
+
+// Server sama dengan TestValidasiBasicSlice
+type Server struct {
+	Name        string   `json:"name,omitempty" validate:"required"`
+	IPAddresses []string `json:"ip_addresses,omitempty" validate:"required,dive,ip"`
+}
+
+// School sama dengan TestValidasiMap
+type School struct {
+	Name    string `json:"name,omitempty" validate:"required,min=2"`
+	Address string `json:"address,omitempty" validate:"required,min=2"`
+}
+
+// Directory membungkus map dari TestValidasiMap
+type Directory struct {
+	Schools map[string]*School `json:"schools,omitempty" validate:"required,dive,keys,min=2,endkeys,required"`
+}
+
+// Contacts membungkus map dari TestValidasiBasicMap
+type Contacts struct {
+	Emails  map[string]string `json:"emails,omitempty" validate:"required,dive,keys,required,min=3,endkeys,required,email,min=12"`
+	Servers map[string]string `json:"servers,omitempty" validate:"omitempty,dive,keys,required,endkeys,required,ip"`
+}
+
+// Profile membungkus alias dan custom tag dari TestAliasTag sampai TestCustomMessageValidation
+type Profile struct {
+	Email      string   `json:"email,omitempty" validate:"app_email"`
+	Category   string   `json:"category,omitempty" validate:"category"`
+	Gender     string   `json:"gender,omitempty" validate:"gender"`
+	Categories []string `json:"categories,omitempty" validate:"min_category=2"`
+	Status     string   `json:"status,omitempty" validate:"omitempty,oneof=active inactive"`
+	Age        int      `json:"age,omitempty" validate:"gte=17,lte=60"`
+	Nickname   *string  `json:"nickname,omitempty" validate:"omitempty,alphanum,max=10"`
+}
+
+// Credential untuk eqfield, nefield dan or (|)
+type Credential struct {
+	Password string `json:"password,omitempty" validate:"required,min=6"`
+	Confirm  string `json:"confirm,omitempty" validate:"eqfield=Password"`
+	Previous string `json:"previous,omitempty" validate:"nefield=Password"`
+	Login    string `json:"login,omitempty" validate:"required,email|numeric"`
+}
@@ -0,0 +1,467 @@
+// Code generated by validategen. DO NOT EDIT.
+
+package model
+
+import (
+	"context"
+	"reflect"
+	"strconv"
+	"unicode/utf8"
+
+	"github.com/go-playground/validator/v10"
+	"go-validation/validation"
+)
+
+// Validate untuk validasi Customer tanpa reflection, hasilnya sama dengan validate.StructCtx
+func (s *Customer) Validate(ctx context.Context) error {
+	if s == nil {
+		return &validator.InvalidValidationError{Type: reflect.TypeOf(s)}
+	}
+
+	var errs validator.ValidationErrors
+	s.validateNamespace(ctx, "Customer.", &errs)
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// validateNamespace untuk validasi field Customer dengan namespace ns, dipakai juga oleh struct lain
+func (s *Customer) validateNamespace(ctx context.Context, ns string, errs *validator.ValidationErrors) {
+	if s.Nama == "" {
+		*errs = append(*errs, validation.NewFieldError(ns+"Nama", "Nama", "required", "required", "", s.Nama))
+	} else {
+		if utf8.RuneCountInString(s.Nama) < 2 {
+			*errs = append(*errs, validation.NewFieldError(ns+"Nama", "Nama", "min", "min", "2", s.Nama))
+		}
+	}
+}
+
+// Validate untuk validasi LoginRequest tanpa reflection, hasilnya sama dengan validate.StructCtx
+func (s *LoginRequest) Validate(ctx context.Context) error {
+	if s == nil {
+		return &validator.InvalidValidationError{Type: reflect.TypeOf(s)}
+	}
+
+	var errs validator.ValidationErrors
+	s.validateNamespace(ctx, "LoginRequest.", &errs)
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// validateNamespace untuk validasi field LoginRequest dengan namespace ns, dipakai juga oleh struct lain
+func (s *LoginRequest) validateNamespace(ctx context.Context, ns string, errs *validator.ValidationErrors) {
+	if s.Username == "" {
+		*errs = append(*errs, validation.NewFieldError(ns+"Username", "Username", "required", "required", "", s.Username))
+	} else {
+		if !validation.IsEmail(s.Username) {
+			*errs = append(*errs, validation.NewFieldError(ns+"Username", "Username", "email", "email", "", s.Username))
+		}
+	}
+	if s.Password == "" {
+		*errs = append(*errs, validation.NewFieldError(ns+"Password", "Password", "required", "required", "", s.Password))
+	} else {
+		if utf8.RuneCountInString(s.Password) < 6 {
+			*errs = append(*errs, validation.NewFieldError(ns+"Password", "Password", "min", "min", "6", s.Password))
+		}
+	}
+}
+
+// Validate untuk validasi LoginRequet tanpa reflection, hasilnya sama dengan validate.StructCtx
+func (s *LoginRequet) Validate(ctx context.Context) error {
+	if s == nil {
+		return &validator.InvalidValidationError{Type: reflect.TypeOf(s)}
+	}
+
+	var errs validator.ValidationErrors
+	s.validateNamespace(ctx, "LoginRequet.", &errs)
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// validateNamespace untuk validasi field LoginRequet dengan namespace ns, dipakai juga oleh struct lain
+func (s *LoginRequet) validateNamespace(ctx context.Context, ns string, errs *validator.ValidationErrors) {
+	if s.Username == "" {
+		*errs = append(*errs, validation.NewFieldError(ns+"Username", "Username", "required", "required", "", s.Username))
+	} else {
+		if !validation.IsEmail(s.Username) {
+			*errs = append(*errs, validation.NewFieldError(ns+"Username", "Username", "email", "email", "", s.Username))
+		} else {
+			if utf8.RuneCountInString(s.Username) < 3 {
+				*errs = append(*errs, validation.NewFieldError(ns+"Username", "Username", "min", "min", "3", s.Username))
+			}
+		}
+	}
+	if s.Password == "" {
+		*errs = append(*errs, validation.NewFieldError(ns+"Password", "Password", "required", "required", "", s.Password))
+	} else {
+		if !validation.IsAlpha(s.Password) {
+			*errs = append(*errs, validation.NewFieldError(ns+"Password", "Password", "alpha", "alpha", "", s.Password))
+		} else {
+			if utf8.RuneCountInString(s.Password) < 6 {
+				*errs = append(*errs, validation.NewFieldError(ns+"Password", "Password", "min", "min", "6", s.Password))
+			}
+		}
+	}
+}
+
+// Validate untuk validasi User tanpa reflection, hasilnya sama dengan validate.StructCtx
+func (s *User) Validate(ctx context.Context) error {
+	if s == nil {
+		return &validator.InvalidValidationError{Type: reflect.TypeOf(s)}
+	}
+
+	var errs validator.ValidationErrors
+	s.validateNamespace(ctx, "User.", &errs)
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// validateNamespace untuk validasi field User dengan namespace ns, dipakai juga oleh struct lain
+func (s *User) validateNamespace(ctx context.Context, ns string, errs *validator.ValidationErrors) {
+	if s.Name == "" {
+		*errs = append(*errs, validation.NewFieldError(ns+"Name", "Name", "required", "required", "", s.Name))
+	}
+	if s.Address == nil {
+		*errs = append(*errs, validation.NewFieldError(ns+"Address", "Address", "required", "required", "", s.Address))
+	} else {
+		s.Address.validateNamespace(ctx, ns+"Address.", errs)
+	}
+}
+
+// Validate untuk validasi Member tanpa reflection, hasilnya sama dengan validate.StructCtx
+func (s *Member) Validate(ctx context.Context) error {
+	if s == nil {
+		return &validator.InvalidValidationError{Type: reflect.TypeOf(s)}
+	}
+
+	var errs validator.ValidationErrors
+	s.validateNamespace(ctx, "Member.", &errs)
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// validateNamespace untuk validasi field Member dengan namespace ns, dipakai juga oleh struct lain
+func (s *Member) validateNamespace(ctx context.Context, ns string, errs *validator.ValidationErrors) {
+	if s.Name == "" {
+		*errs = append(*errs, validation.NewFieldError(ns+"Name", "Name", "required", "required", "", s.Name))
+	}
+	if s.Addresses == nil {
+		*errs = append(*errs, validation.NewFieldError(ns+"Addresses", "Addresses", "required", "required", "", s.Addresses))
+	} else {
+		for i1 := range s.Addresses {
+			s.Addresses[i1].validateNamespace(ctx, ns+"Addresses["+strconv.Itoa(i1)+"].", errs)
+		}
+	}
+}
+
+// Validate untuk validasi Server tanpa reflection, hasilnya sama dengan validate.StructCtx
+func (s *Server) Validate(ctx context.Context) error {
+	if s == nil {
+		return &validator.InvalidValidationError{Type: reflect.TypeOf(s)}
+	}
+
+	var errs validator.ValidationErrors
+	s.validateNamespace(ctx, "Server.", &errs)
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// validateNamespace untuk validasi field Server dengan namespace ns, dipakai juga oleh struct lain
+func (s *Server) validateNamespace(ctx context.Context, ns string, errs *validator.ValidationErrors) {
+	if s.Name == "" {
+		*errs = append(*errs, validation.NewFieldError(ns+"Name", "Name", "required", "required", "", s.Name))
+	}
+	if s.IPAddresses == nil {
+		*errs = append(*errs, validation.NewFieldError(ns+"IPAddresses", "IPAddresses", "required", "required", "", s.IPAddresses))
+	} else {
+		for i2 := range s.IPAddresses {
+			if !validation.IsIP(s.IPAddresses[i2]) {
+				*errs = append(*errs, validation.NewFieldError(ns+"IPAddresses["+strconv.Itoa(i2)+"]", "IPAddresses["+strconv.Itoa(i2)+"]", "ip", "ip", "", s.IPAddresses[i2]))
+			}
+		}
+	}
+}
+
+// Validate untuk validasi Directory tanpa reflection, hasilnya sama dengan validate.StructCtx
+func (s *Directory) Validate(ctx context.Context) error {
+	if s == nil {
+		return &validator.InvalidValidationError{Type: reflect.TypeOf(s)}
+	}
+
+	var errs validator.ValidationErrors
+	s.validateNamespace(ctx, "Directory.", &errs)
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// validateNamespace untuk validasi field Directory dengan namespace ns, dipakai juga oleh struct lain
+func (s *Directory) validateNamespace(ctx context.Context, ns string, errs *validator.ValidationErrors) {
+	if s.Schools == nil {
+		*errs = append(*errs, validation.NewFieldError(ns+"Schools", "Schools", "required", "required", "", s.Schools))
+	} else {
+		for key3, item3 := range s.Schools {
+			if utf8.RuneCountInString(key3) < 2 {
+				*errs = append(*errs, validation.NewFieldError(ns+"Schools["+key3+"]", "Schools["+key3+"]", "min", "min", "2", key3))
+			}
+			if item3 == nil {
+				*errs = append(*errs, validation.NewFieldError(ns+"Schools["+key3+"]", "Schools["+key3+"]", "required", "required", "", item3))
+			} else {
+				item3.validateNamespace(ctx, ns+"Schools["+key3+"].", errs)
+			}
+		}
+	}
+}
+
+// Validate untuk validasi Contacts tanpa reflection, hasilnya sama dengan validate.StructCtx
+func (s *Contacts) Validate(ctx context.Context) error {
+	if s == nil {
+		return &validator.InvalidValidationError{Type: reflect.TypeOf(s)}
+	}
+
+	var errs validator.ValidationErrors
+	s.validateNamespace(ctx, "Contacts.", &errs)
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// validateNamespace untuk validasi field Contacts dengan namespace ns, dipakai juga oleh struct lain
+func (s *Contacts) validateNamespace(ctx context.Context, ns string, errs *validator.ValidationErrors) {
+	if s.Emails == nil {
+		*errs = append(*errs, validation.NewFieldError(ns+"Emails", "Emails", "required", "required", "", s.Emails))
+	} else {
+		for key4, item4 := range s.Emails {
+			if key4 == "" {
+				*errs = append(*errs, validation.NewFieldError(ns+"Emails["+key4+"]", "Emails["+key4+"]", "required", "required", "", key4))
+			} else {
+				if utf8.RuneCountInString(key4) < 3 {
+					*errs = append(*errs, validation.NewFieldError(ns+"Emails["+key4+"]", "Emails["+key4+"]", "min", "min", "3", key4))
+				}
+			}
+			if item4 == "" {
+				*errs = append(*errs, validation.NewFieldError(ns+"Emails["+key4+"]", "Emails["+key4+"]", "required", "required", "", item4))
+			} else {
+				if !validation.IsEmail(item4) {
+					*errs = append(*errs, validation.NewFieldError(ns+"Emails["+key4+"]", "Emails["+key4+"]", "email", "email", "", item4))
+				} else {
+					if utf8.RuneCountInString(item4) < 12 {
+						*errs = append(*errs, validation.NewFieldError(ns+"Emails["+key4+"]", "Emails["+key4+"]", "min", "min", "12", item4))
+					}
+				}
+			}
+		}
+	}
+	if s.Servers != nil {
+		for key5, item5 := range s.Servers {
+			if key5 == "" {
+				*errs = append(*errs, validation.NewFieldError(ns+"Servers["+key5+"]", "Servers["+key5+"]", "required", "required", "", key5))
+			}
+			if item5 == "" {
+				*errs = append(*errs, validation.NewFieldError(ns+"Servers["+key5+"]", "Servers["+key5+"]", "required", "required", "", item5))
+			} else {
+				if !validation.IsIP(item5) {
+					*errs = append(*errs, validation.NewFieldError(ns+"Servers["+key5+"]", "Servers["+key5+"]", "ip", "ip", "", item5))
+				}
+			}
+		}
+	}
+}
+
+// Validate untuk validasi Profile tanpa reflection, hasilnya sama dengan validate.StructCtx
+func (s *Profile) Validate(ctx context.Context) error {
+	if s == nil {
+		return &validator.InvalidValidationError{Type: reflect.TypeOf(s)}
+	}
+
+	var errs validator.ValidationErrors
+	s.validateNamespace(ctx, "Profile.", &errs)
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// validateNamespace untuk validasi field Profile dengan namespace ns, dipakai juga oleh struct lain
+func (s *Profile) validateNamespace(ctx context.Context, ns string, errs *validator.ValidationErrors) {
+	if s.Email == "" {
+		*errs = append(*errs, validation.NewFieldError(ns+"Email", "Email", "app_email", "required", "", s.Email))
+	} else {
+		if !validation.IsEmail(s.Email) {
+			*errs = append(*errs, validation.NewFieldError(ns+"Email", "Email", "app_email", "email", "", s.Email))
+		} else {
+			if utf8.RuneCountInString(s.Email) < 15 {
+				*errs = append(*errs, validation.NewFieldError(ns+"Email", "Email", "app_email", "min", "15", s.Email))
+			}
+		}
+	}
+	if !validation.InSet("category", s.Category) {
+		*errs = append(*errs, validation.NewFieldError(ns+"Category", "Category", "category", "category", "", s.Category))
+	}
+	if !validation.InSet("gender", s.Gender) {
+		*errs = append(*errs, validation.NewFieldError(ns+"Gender", "Gender", "gender", "gender", "", s.Gender))
+	}
+	if !validation.HasMinCategory(s.Categories, 2) {
+		*errs = append(*errs, validation.NewFieldError(ns+"Categories", "Categories", "min_category", "min_category", "2", s.Categories))
+	}
+	if s.Status != "" {
+		if !(s.Status == "active" || s.Status == "inactive") {
+			*errs = append(*errs, validation.NewFieldError(ns+"Status", "Status", "oneof", "oneof", "active inactive", s.Status))
+		}
+	}
+	if int64(s.Age) < 17 {
+		*errs = append(*errs, validation.NewFieldError(ns+"Age", "Age", "gte", "gte", "17", s.Age))
+	} else {
+		if int64(s.Age) > 60 {
+			*errs = append(*errs, validation.NewFieldError(ns+"Age", "Age", "lte", "lte", "60", s.Age))
+		}
+	}
+	if s.Nickname != nil {
+		if !validation.IsAlphanum((*s.Nickname)) {
+			*errs = append(*errs, validation.NewFieldError(ns+"Nickname", "Nickname", "alphanum", "alphanum", "", (*s.Nickname)))
+		} else {
+			if utf8.RuneCountInString((*s.Nickname)) > 10 {
+				*errs = append(*errs, validation.NewFieldError(ns+"Nickname", "Nickname", "max", "max", "10", (*s.Nickname)))
+			}
+		}
+	}
+}
+
+// Validate untuk validasi Credential tanpa reflection, hasilnya sama dengan validate.StructCtx
+func (s *Credential) Validate(ctx context.Context) error {
+	if s == nil {
+		return &validator.InvalidValidationError{Type: reflect.TypeOf(s)}
+	}
+
+	var errs validator.ValidationErrors
+	s.validateNamespace(ctx, "Credential.", &errs)
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// validateNamespace untuk validasi field Credential dengan namespace ns, dipakai juga oleh struct lain
+func (s *Credential) validateNamespace(ctx context.Context, ns string, errs *validator.ValidationErrors) {
+	if s.Password == "" {
+		*errs = append(*errs, validation.NewFieldError(ns+"Password", "Password", "required", "required", "", s.Password))
+	} else {
+		if utf8.RuneCountInString(s.Password) < 6 {
+			*errs = append(*errs, validation.NewFieldError(ns+"Password", "Password", "min", "min", "6", s.Password))
+		}
+	}
+	if s.Confirm != s.Password {
+		*errs = append(*errs, validation.NewFieldError(ns+"Confirm", "Confirm", "eqfield", "eqfield", "Password", s.Confirm))
+	}
+	if s.Previous == s.Password {
+		*errs = append(*errs, validation.NewFieldError(ns+"Previous", "Previous", "nefield", "nefield", "Password", s.Previous))
+	}
+	if s.Login == "" {
+		*errs = append(*errs, validation.NewFieldError(ns+"Login", "Login", "required", "required", "", s.Login))
+	} else {
+		if !(validation.IsEmail(s.Login) || validation.IsNumeric(s.Login)) {
+			*errs = append(*errs, validation.NewFieldError(ns+"Login", "Login", "email|numeric", "email|numeric", "", s.Login))
+		}
+	}
+}
+
+// Validate untuk validasi Address tanpa reflection, hasilnya sama dengan validate.StructCtx
+func (s *Address) Validate(ctx context.Context) error {
+	if s == nil {
+		return &validator.InvalidValidationError{Type: reflect.TypeOf(s)}
+	}
+
+	var errs validator.ValidationErrors
+	s.validateNamespace(ctx, "Address.", &errs)
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// validateNamespace untuk validasi field Address dengan namespace ns, dipakai juga oleh struct lain
+func (s *Address) validateNamespace(ctx context.Context, ns string, errs *validator.ValidationErrors) {
+	if s.City == "" {
+		*errs = append(*errs, validation.NewFieldError(ns+"City", "City", "required", "required", "", s.City))
+	}
+	if s.Country == "" {
+		*errs = append(*errs, validation.NewFieldError(ns+"Country", "Country", "required", "required", "", s.Country))
+	}
+}
+
+// Validate untuk validasi MemberAddress tanpa reflection, hasilnya sama dengan validate.StructCtx
+func (s *MemberAddress) Validate(ctx context.Context) error {
+	if s == nil {
+		return &validator.InvalidValidationError{Type: reflect.TypeOf(s)}
+	}
+
+	var errs validator.ValidationErrors
+	s.validateNamespace(ctx, "MemberAddress.", &errs)
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// validateNamespace untuk validasi field MemberAddress dengan namespace ns, dipakai juga oleh struct lain
+func (s *MemberAddress) validateNamespace(ctx context.Context, ns string, errs *validator.ValidationErrors) {
+	if s.City == "" {
+		*errs = append(*errs, validation.NewFieldError(ns+"City", "City", "required", "required", "", s.City))
+	} else {
+		if utf8.RuneCountInString(s.City) < 2 {
+			*errs = append(*errs, validation.NewFieldError(ns+"City", "City", "min", "min", "2", s.City))
+		}
+	}
+	if s.Country == "" {
+		*errs = append(*errs, validation.NewFieldError(ns+"Country", "Country", "required", "required", "", s.Country))
+	} else {
+		if utf8.RuneCountInString(s.Country) < 2 {
+			*errs = append(*errs, validation.NewFieldError(ns+"Country", "Country", "min", "min", "2", s.Country))
+		}
+	}
+}
+
+// Validate untuk validasi School tanpa reflection, hasilnya sama dengan validate.StructCtx
+func (s *School) Validate(ctx context.Context) error {
+	if s == nil {
+		return &validator.InvalidValidationError{Type: reflect.TypeOf(s)}
+	}
+
+	var errs validator.ValidationErrors
+	s.validateNamespace(ctx, "School.", &errs)
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// validateNamespace untuk validasi field School dengan namespace ns, dipakai juga oleh struct lain
+func (s *School) validateNamespace(ctx context.Context, ns string, errs *validator.ValidationErrors) {
+	if s.Name == "" {
+		*errs = append(*errs, validation.NewFieldError(ns+"Name", "Name", "required", "required", "", s.Name))
+	} else {
+		if utf8.RuneCountInString(s.Name) < 2 {
+			*errs = append(*errs, validation.NewFieldError(ns+"Name", "Name", "min", "min", "2", s.Name))
+		}
+	}
+	if s.Address == "" {
+		*errs = append(*errs, validation.NewFieldError(ns+"Address", "Address", "required", "required", "", s.Address))
+	} else {
+		if utf8.RuneCountInString(s.Address) < 2 {
+			*errs = append(*errs, validation.NewFieldError(ns+"Address", "Address", "min", "min", "2", s.Address))
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package test
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"go-validation/jsonschema"
+	"go-validation/openapi"
+	"testing"
+)
+
+type OpenAPIAddress struct {
+	City    string `json:"city,omitempty" validate:"required"`
+	Country string `json:"country,omitempty" validate:"required"`
+}
+
+type OpenAPIUser struct {
+	Name    string          `json:"name,omitempty" validate:"required"`
+	Phone   string          `json:"phone,omitempty" validate:"phone"`
+	Address *OpenAPIAddress `json:"address,omitempty" validate:"required"`
+}
+
+type OpenAPILoginRequest struct {
+	Username string `json:"username,omitempty" validate:"required,email"`
+	Password string `json:"password,omitempty" validate:"required,min=6"`
+}
+
+// TestOpenAPIDocument untuk membuat components.schemas dari type request dan response
+// nested struct ikut dibuat dan direferensikan ke #/components/schemas
+func TestOpenAPIDocument(t *testing.T) {
+	builder := openapi.NewBuilder("User API", "1.0.0").Describe("API untuk data user")
+	builder.Generator().Register("phone", jsonschema.Pattern(`^\+62[0-9]{8,12}$`))
+
+	assert.Nil(t, builder.Register(OpenAPILoginRequest{}, &OpenAPIUser{}))
+
+	content, err := json.Marshal(builder.Document())
+	assert.Nil(t, err)
+
+	expectDocument := `{
+		"openapi": "3.1.0",
+		"info": {"title": "User API", "version": "1.0.0", "description": "API untuk data user"},
+		"jsonSchemaDialect": "https://json-schema.org/draft/2020-12/schema",
+		"components": {
+			"schemas": {
+				"OpenAPILoginRequest": {
+					"type": "object",
+					"properties": {
+						"username": {"type": "string", "format": "email", "minLength": 1},
+						"password": {"type": "string", "minLength": 6}
+					},
+					"required": ["username", "password"]
+				},
+				"OpenAPIUser": {
+					"type": "object",
+					"properties": {
+						"name": {"type": "string", "minLength": 1},
+						"phone": {"type": "string", "pattern": "^\\+62[0-9]{8,12}$"},
+						"address": {"$ref": "#/components/schemas/OpenAPIAddress"}
+					},
+					"required": ["name", "address"]
+				},
+				"OpenAPIAddress": {
+					"type": "object",
+					"properties": {
+						"city": {"type": "string", "minLength": 1},
+						"country": {"type": "string", "minLength": 1}
+					},
+					"required": ["city", "country"]
+				}
+			}
+		}
+	}`
+
+	assert.JSONEq(t, expectDocument, string(content))
+
+	ref, err := builder.Ref(OpenAPIUser{})
+	assert.Nil(t, err)
+	assert.Equal(t, "#/components/schemas/OpenAPIUser", ref.Ref)
+}
+
+// TestOpenAPIRegisterError untuk type yang tidak bisa didaftarkan sebagai schema
+func TestOpenAPIRegisterError(t *testing.T) {
+	type InvalidRequest struct {
+		Password string `json:"password" validate:"min=abc"`
+	}
+
+	scenario := []struct {
+		Name  string
+		Input any
+	}{
+		{Name: "test register bukan struct", Input: "reo"},
+		{Name: "test register struct tanpa nama", Input: struct{ Name string }{}},
+		{Name: "test register nil", Input: nil},
+		{Name: "test register tag tidak valid", Input: InvalidRequest{}},
+	}
+
+	for _, testScenario := range scenario {
+		t.Run(testScenario.Name, func(t *testing.T) {
+			assert.NotNil(t, openapi.NewBuilder("User API", "1.0.0").Register(testScenario.Input))
+		})
+	}
+}
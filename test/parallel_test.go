@@ -0,0 +1,165 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"go-validation/validation"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// ParallelAddress adalah item slice untuk validasi paralel
+type ParallelAddress struct {
+	City    string `json:"city,omitempty" validate:"required,min=2"`
+	Country string `json:"country,omitempty" validate:"required,min=2"`
+}
+
+// ParallelUser adalah struct dengan slice dan map besar yang divalidasi paralel
+// Scores dan Backups memakai tag cross field sehingga divalidasi tanpa worker
+type ParallelUser struct {
+	Name      string            `json:"name,omitempty" validate:"required"`
+	Addresses []ParallelAddress `json:"addresses,omitempty" validate:"required,max=5000,dive"`
+	Emails    map[string]string `json:"emails,omitempty" validate:"omitempty,dive,keys,min=3,endkeys,email"`
+	Tags      []string          `json:"tags,omitempty" validate:"dive,required,alpha"`
+	Phone     string            `json:"phone,omitempty" validate:"required,numeric"`
+	MaxScore  int               `json:"max_score,omitempty"`
+	Scores    []int             `json:"scores,omitempty" validate:"required_with=Phone,dive,ltefield=MaxScore"`
+	Backups   []string          `json:"backups,omitempty" validate:"dive,necsfield=Phone"`
+}
+
+// newParallelUser untuk membuat ParallelUser dengan item yang tidak valid di beberapa index
+func newParallelUser(size int) ParallelUser {
+	user := ParallelUser{
+		Addresses: make([]ParallelAddress, size),
+		Emails:    map[string]string{},
+		Tags:      make([]string, size),
+	}
+
+	for i := 0; i < size; i++ {
+		user.Addresses[i] = ParallelAddress{City: "Jakarta", Country: "ID"}
+		user.Tags[i] = "tag"
+		user.Emails[fmt.Sprintf("user%05d", i)] = fmt.Sprintf("user%d@gmail.com", i)
+
+		switch {
+		case i%997 == 0:
+			user.Addresses[i] = ParallelAddress{City: "J"}
+		case i%1499 == 0:
+			user.Tags[i] = "tag1"
+			user.Emails[fmt.Sprintf("user%05d", i)] = "reo"
+		}
+	}
+
+	return user
+}
+
+// parallelNamespaces untuk mengambil namespace, field dan tag dari setiap error sesuai urutan
+func parallelNamespaces(err error) []string {
+	validationErrors, _ := err.(validator.ValidationErrors)
+
+	var namespaces []string
+	for _, fieldError := range validationErrors {
+		namespaces = append(namespaces, strings.Join([]string{
+			fieldError.Namespace(), fieldError.StructNamespace(), fieldError.Field(), fieldError.StructField(),
+			fieldError.Tag(), fieldError.Param(), fmt.Sprint(fieldError.Value()), fieldError.Error(),
+		}, " | "))
+	}
+	return namespaces
+}
+
+// newParallelCrossField untuk membuat ParallelUser dengan Scores dan Backups yang tidak valid di beberapa index
+func newParallelCrossField(size int) ParallelUser {
+	user := ParallelUser{Name: "reo", Phone: "0812", MaxScore: 100}
+
+	for i := 0; i < size; i++ {
+		score, phone := i%100, fmt.Sprintf("0813%d", i)
+		if i%701 == 0 {
+			score, phone = 101, user.Phone
+		}
+
+		user.Scores = append(user.Scores, score)
+		user.Backups = append(user.Backups, phone)
+	}
+
+	return user
+}
+
+// TestParallelValidator untuk hasil validasi paralel sama dengan validate.StructCtx
+// slice dan array harus sama persis urutannya, map diurutkan berdasarkan key
+func TestParallelValidator(t *testing.T) {
+	// rules dari file mengganti tag Backups (cross field) dan Tags, item nya tetap divalidasi dengan tag dari file
+	rules, err := validation.RuleLoader{Types: validation.RuleTypes{"ParallelUser": ParallelUser{}}}.
+		Parse("rules.yaml", []byte("ParallelUser:\n  tags: dive,required,min=4\n  backups: dive,email\n"))
+	assert.Nil(t, err)
+
+	scenario := []struct {
+		Name    string
+		Options []validation.Option
+		Input   ParallelUser
+	}{
+		{Name: "test parallel 4000 item", Input: newParallelUser(4000)},
+		{Name: "test parallel nama field dari json", Options: []validation.Option{validation.WithFieldNameTag("json")}, Input: newParallelUser(3000)},
+		{Name: "test parallel tag sebelum dive gagal", Input: newParallelUser(5001)},
+		{Name: "test parallel dibawah min items", Input: newParallelUser(10)},
+		{Name: "test parallel slice kosong", Input: ParallelUser{Name: "reo", Phone: "0812"}},
+		{Name: "test parallel tag cross field tanpa worker", Input: newParallelCrossField(3000)},
+		{Name: "test parallel tag cross field sebelum dive", Input: ParallelUser{Phone: "0812", Backups: newParallelCrossField(100).Backups}},
+		{Name: "test parallel rules dari file", Options: []validation.Option{validation.WithRuleSet(rules)}, Input: newParallelCrossField(3000)},
+		{Name: "test parallel rules dari file item valid", Options: []validation.Option{validation.WithRuleSet(rules)}, Input: newParallelUser(3000)},
+	}
+
+	for _, testScenario := range scenario {
+		t.Run(testScenario.Name, func(t *testing.T) {
+			validate, err := validation.New(testScenario.Options...)
+			assert.Nil(t, err)
+
+			parallel := validation.NewParallelValidator(validate)
+			parallel.ChunkSize = 100
+			parallel.Workers = 4
+			parallel.MinItems = 50
+
+			serial := validate.StructCtx(context.Background(), testScenario.Input)
+			actual := parallel.StructCtx(context.Background(), &testScenario.Input)
+			assert.Equal(t, serial == nil, actual == nil)
+
+			expected := parallelNamespaces(serial)
+			result := parallelNamespaces(actual)
+
+			// error dari map di validator urutannya acak, bandingkan isinya lalu pastikan urut berdasarkan key
+			isEmail := func(namespace string) bool { return strings.Contains(namespace, "mails[") }
+			assert.ElementsMatch(t, expected, result)
+			assert.Equal(t, slices.DeleteFunc(slices.Clone(expected), isEmail), slices.DeleteFunc(slices.Clone(result), isEmail))
+			emails := slices.DeleteFunc(slices.Clone(result), func(namespace string) bool { return !isEmail(namespace) })
+			assert.True(t, slices.IsSorted(emails))
+
+			// hasil paralel selalu sama setiap kali dijalankan
+			assert.Equal(t, result, parallelNamespaces(parallel.StructCtx(context.Background(), testScenario.Input)))
+		})
+	}
+}
+
+// TestParallelValidatorCancel untuk validasi berhenti dan mengembalikan error context saat dibatalkan
+func TestParallelValidatorCancel(t *testing.T) {
+	validate, err := validation.New()
+	assert.Nil(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	parallel := validation.NewParallelValidator(validate)
+	parallel.MinItems = 10
+
+	err = parallel.StructCtx(ctx, newParallelUser(1000))
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+// TestParallelValidatorInvalid untuk value yang bukan struct dikembalikan sama seperti validate.StructCtx
+func TestParallelValidatorInvalid(t *testing.T) {
+	validate, err := validation.New()
+	assert.Nil(t, err)
+
+	var user *ParallelUser
+	assert.Equal(t, validate.StructCtx(context.Background(), user), validation.NewParallelValidator(validate).StructCtx(context.Background(), user))
+}
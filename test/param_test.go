@@ -0,0 +1,152 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"go-validation/validation"
+	"go-validation/validationtest"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestParamValidation untuk custom tag yang parameternya punya type (duration, list, regex)
+// function validasi menerima parameter yang sudah diubah, bukan string
+func TestParamValidation(t *testing.T) {
+	validate, err := validation.New(
+		validation.WithParamValidation("max_age", validation.DurationParam, func(field validator.FieldLevel, param time.Duration) bool {
+			return time.Duration(field.Field().Int()) <= param
+		}),
+		validation.WithParamValidation("prefix", validation.ListParam, func(field validator.FieldLevel, param []string) bool {
+			return slices.ContainsFunc(param, func(prefix string) bool {
+				return strings.HasPrefix(field.Field().String(), prefix)
+			})
+		}),
+		validation.WithParamValidation("pattern", validation.RegexParam, func(field validator.FieldLevel, param *regexp.Regexp) bool {
+			return param.MatchString(field.Field().String())
+		}),
+	)
+	assert.Nil(t, err)
+
+	validationtest.RunVar(t, validate, []validationtest.VarScenario[any]{
+		{Name: "test duration success", Input: 2 * time.Hour, Tag: "max_age=24h"},
+		{Name: "test duration failed", Input: 48 * time.Hour, Tag: "max_age=24h", ExpectErrors: []validationtest.Failure{{Tag: "max_age"}}},
+		{Name: "test duration invalid param", Input: time.Hour, Tag: "max_age=1day", ExpectErrors: []validationtest.Failure{{Tag: "max_age"}}},
+		{Name: "test list success", Input: "ID-001", Tag: "prefix=ID EN"},
+		{Name: "test list failed", Input: "FR-001", Tag: "prefix=ID EN", ExpectErrors: []validationtest.Failure{{Tag: "prefix"}}},
+		{Name: "test regex success", Input: "ABC", Tag: "pattern=^[A-Z]+$"},
+		{Name: "test regex failed", Input: "abc", Tag: "pattern=^[A-Z]+$", ExpectErrors: []validationtest.Failure{{Tag: "pattern"}}},
+		{Name: "test regex invalid param", Input: "abc", Tag: "pattern=[a-", ExpectErrors: []validationtest.Failure{{Tag: "pattern"}}},
+	})
+
+	// validation.VarCtx mengembalikan parameter yang tidak valid sebagai *ParamError, bukan error validasi field
+	scenario := []struct {
+		Name  string
+		Input any
+		Tag   string
+	}{
+		{Name: "test duration invalid param error", Input: time.Hour, Tag: "max_age=1day"},
+		{Name: "test regex invalid param error", Input: "abc", Tag: "pattern=[a-"},
+	}
+
+	for _, testScenario := range scenario {
+		t.Run(testScenario.Name, func(t *testing.T) {
+			var paramError *validation.ParamError
+			assert.ErrorAs(t, validation.VarCtx(context.Background(), validate, testScenario.Input, testScenario.Tag), &paramError)
+		})
+	}
+}
+
+// TestParamValidationError untuk parameter yang tidak valid dikembalikan sebagai *ParamError sebelum validasi dijalankan
+// validator mentah tidak panic, field nya hanya dianggap gagal
+func TestParamValidationError(t *testing.T) {
+	validate, err := validation.New()
+	assert.Nil(t, err)
+
+	type InvalidCategoryRequest struct {
+		Categories []string `validate:"min_category=x"`
+	}
+
+	for i := 0; i < 2; i++ {
+		err = validation.VarCtx(context.Background(), validate, []string{"a", "b"}, "min_category=x")
+		assert.EqualError(t, err, `invalid int param "x" for tag min_category: strconv.Atoi: parsing "x": invalid syntax`)
+	}
+
+	var paramError *validation.ParamError
+	var validationErrors validator.ValidationErrors
+
+	err = validation.StructCtx(context.Background(), validate, &InvalidCategoryRequest{Categories: []string{"a"}})
+	assert.ErrorAs(t, err, &paramError)
+	assert.False(t, errors.As(err, &validationErrors))
+
+	err = validation.NewParallelValidator(validate).StructCtx(context.Background(), &InvalidCategoryRequest{})
+	assert.ErrorAs(t, err, &paramError)
+
+	assert.NotPanics(t, func() {
+		err = validate.VarCtx(context.Background(), []string{"a", "b"}, "min_category=x")
+	})
+	validationtest.AssertFailures(t, err, []validationtest.Failure{{Tag: "min_category"}})
+}
+
+// TestCheckParam untuk mengecek parameter tag sebelum validasi dijalankan
+func TestCheckParam(t *testing.T) {
+	validate, err := validation.New()
+	assert.Nil(t, err)
+
+	type CategoryRequest struct {
+		Categories []string `validate:"required,min_category=2"`
+	}
+
+	type InvalidCategoryRequest struct {
+		Name    string           `validate:"required"`
+		Request *CategoryRequest `validate:"required"`
+		Items   []struct {
+			Categories []string `validate:"min_category=dua"`
+		}
+	}
+
+	assert.Nil(t, validation.CheckTag(validate, "required,min_category=2"))
+	assert.Nil(t, validation.CheckStruct(validate, CategoryRequest{}))
+
+	err = validation.CheckTag(validate, "required,min_category=x")
+	var paramError *validation.ParamError
+	if assert.ErrorAs(t, err, &paramError) {
+		assert.Equal(t, "min_category", paramError.Tag)
+		assert.Equal(t, "x", paramError.Param)
+		assert.Equal(t, "int", paramError.Type)
+	}
+
+	err = validation.CheckStruct(validate, &InvalidCategoryRequest{})
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), `.Categories: invalid int param "dua" for tag min_category`)
+	}
+
+	_, err = validation.RuleLoader{Types: validation.RuleTypes{"CategoryRequest": CategoryRequest{}}}.
+		Parse("rules.yaml", []byte("CategoryRequest:\n  Categories: min_category=x\n"))
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), `rules.yaml:2:15: CategoryRequest.Categories: invalid int param "x" for tag min_category`)
+	}
+}
+
+// TestCheckParamPerValidator untuk pengecekan parameter sesuai tag yang didaftarkan di masing-masing validator
+func TestCheckParamPerValidator(t *testing.T) {
+	validate, err := validation.New()
+	assert.Nil(t, err)
+
+	other, err := validation.New(validation.WithParamValidation(validation.TagMinCategory, validation.DurationParam, func(field validator.FieldLevel, param time.Duration) bool {
+		return true
+	}))
+	assert.Nil(t, err)
+
+	assert.Nil(t, validation.CheckTag(other, "min_category=1h"))
+	assert.NotNil(t, validation.CheckTag(validate, "min_category=1h"))
+	assert.Nil(t, validation.CheckTag(validate, "min_category=2"))
+	assert.NotNil(t, validation.CheckTag(other, "min_category=2"))
+
+	// validator tanpa WithParamValidation tidak punya parameter yang dicek
+	assert.Nil(t, validation.CheckTag(validator.New(), "min_category=x"))
+}
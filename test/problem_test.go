@@ -0,0 +1,200 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"go-validation/validation"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// TestNewProblem untuk mengubah validator.ValidationErrors menjadi dokumen problem+json
+// pointer harus benar untuk nested pointer struct, slice dan map yang menggunakan dive
+func TestNewProblem(t *testing.T) {
+	validate, err := validation.New(validation.WithFieldNameTag("json"))
+	assert.Nil(t, err)
+
+	catalog := validation.NewCatalog()
+
+	type Address struct {
+		City    string `json:"city,omitempty" validate:"required"`
+		Country string `json:"country,omitempty" validate:"required"`
+	}
+
+	type User struct {
+		Name      string            `json:"name,omitempty" validate:"required"`
+		Address   *Address          `json:"address,omitempty" validate:"required"`
+		Addresses []Address         `json:"addresses,omitempty" validate:"required,dive"`
+		Emails    map[string]string `json:"emails,omitempty" validate:"dive,keys,min=3,endkeys,email"`
+	}
+
+	input := User{
+		Name:      "reo",
+		Address:   &Address{Country: "Indonesia"},
+		Addresses: []Address{{City: "Jakarta", Country: "Indonesia"}, {City: "Bandung"}},
+		Emails:    map[string]string{"work/main": "reo"},
+	}
+
+	expectProblem := validation.Problem{
+		Type:   "about:blank",
+		Title:  "Unprocessable Entity",
+		Status: http.StatusUnprocessableEntity,
+		Detail: "validation failed",
+		InvalidParams: []validation.InvalidParam{
+			{Name: "address.city", Reason: "city is required", Code: "FIELD_REQUIRED", Pointer: "/address/city"},
+			{Name: "addresses[1].country", Reason: "country is required", Code: "FIELD_REQUIRED", Pointer: "/addresses/1/country"},
+			{Name: "emails[work/main]", Reason: "emails[work/main] must be a valid email address, got 'reo'", Code: "INVALID_EMAIL", Pointer: "/emails/work~1main"},
+		},
+	}
+
+	err = validate.StructCtx(context.Background(), input)
+	assert.NotNil(t, err)
+	assert.Equal(t, expectProblem, validation.NewProblem(err, catalog, validation.LocaleEnglish))
+}
+
+// TestProblemVariable untuk pointer dari validasi variabel slice
+func TestProblemVariable(t *testing.T) {
+	validate, err := validation.New()
+	assert.Nil(t, err)
+
+	err = validate.VarCtx(context.Background(), []string{"172.18.41.238", "qwert"}, "required,dive,ip")
+	assert.NotNil(t, err)
+
+	problem := validation.NewProblem(err, validation.NewCatalog(), validation.LocaleIndonesian)
+	assert.Equal(t, []validation.InvalidParam{
+		{Name: "[1]", Reason: "[1] harus berupa alamat IP yang valid, bukan 'qwert'", Code: "INVALID_IP_ADDRESS", Pointer: "/1"},
+	}, problem.InvalidParams)
+}
+
+// TestHTTPProblemDetails untuk menulis response error handler dalam format problem+json
+func TestHTTPProblemDetails(t *testing.T) {
+	validate, err := validation.New(validation.WithFieldNameTag("json"))
+	assert.Nil(t, err)
+
+	handler := validation.Handler(validate, func(w http.ResponseWriter, r *http.Request, request HTTPLoginRequest) {
+		w.WriteHeader(http.StatusOK)
+	}, validation.WithProblemDetails())
+
+	scenario := []struct {
+		Name          string
+		Body          string
+		ExpectProblem validation.Problem
+	}{
+		{
+			Name: "test problem details validation failed",
+			Body: `{"username":"reo","password":"123456"}`,
+			ExpectProblem: validation.Problem{
+				Type:     "about:blank",
+				Title:    "Unprocessable Entity",
+				Status:   http.StatusUnprocessableEntity,
+				Detail:   "validation failed",
+				Instance: "/login",
+				InvalidParams: []validation.InvalidParam{
+					{Name: "username", Reason: "username must be a valid email address, got 'reo'", Code: "INVALID_EMAIL", Pointer: "/username"},
+				},
+			},
+		},
+		{
+			Name: "test problem details invalid body",
+			Body: `[]`,
+			ExpectProblem: validation.Problem{
+				Type:     "about:blank",
+				Title:    "Bad Request",
+				Status:   http.StatusBadRequest,
+				Detail:   "invalid request body: json: cannot unmarshal array into Go value of type test.HTTPLoginRequest",
+				Instance: "/login",
+			},
+		},
+	}
+
+	for _, testScenario := range scenario {
+		t.Run(testScenario.Name, func(t *testing.T) {
+			request := httptest.NewRequest(http.MethodPost, "/login", strings.NewReader(testScenario.Body))
+			recorder := httptest.NewRecorder()
+
+			handler.ServeHTTP(recorder, request)
+
+			var problem validation.Problem
+			assert.Nil(t, json.NewDecoder(recorder.Body).Decode(&problem))
+			assert.Equal(t, validation.ProblemContentType, recorder.Header().Get("Content-Type"))
+			assert.Equal(t, testScenario.ExpectProblem.Status, recorder.Code)
+			assert.Equal(t, testScenario.ExpectProblem, problem)
+		})
+	}
+}
+
+// ProblemAddress dan ProblemUser dipakai untuk pointer dari value yang divalidasi
+type ProblemAddress struct {
+	City string `json:"city,omitempty" validate:"required"`
+}
+
+type ProblemUser struct {
+	Address   *ProblemAddress             `json:"address,omitempty" validate:"required"`
+	Addresses []ProblemAddress            `json:"addresses,omitempty" validate:"dive"`
+	Emails    map[string]string           `json:"emails,omitempty" validate:"dive,email"`
+	Groups    map[string][]ProblemAddress `json:"groups" validate:"dive,dive"`
+}
+
+// TestNewProblemFor untuk pointer dari value yang divalidasi
+// nama field dari tag json walaupun validator tidak memakai WithFieldNameTag dan key map berisi titik atau kurung siku
+func TestNewProblemFor(t *testing.T) {
+	input := ProblemUser{
+		Address:   &ProblemAddress{},
+		Addresses: []ProblemAddress{{City: "Jakarta"}, {}},
+		Emails:    map[string]string{"reo.work": "reo", "a]b": "budi", "a": "reo@gmail.com"},
+		Groups:    map[string][]ProblemAddress{"x].y[0": {{}}},
+	}
+
+	pointers := func(problem validation.Problem) []string {
+		var pointers []string
+		for _, param := range problem.InvalidParams {
+			pointers = append(pointers, param.Pointer)
+		}
+		return pointers
+	}
+
+	validate, err := validation.New()
+	assert.Nil(t, err)
+
+	err = validate.StructCtx(context.Background(), input)
+	assert.ElementsMatch(t, []string{
+		"/address/city",
+		"/addresses/1/city",
+		"/emails/reo.work",
+		"/emails/a]b",
+		"/groups/x].y[0/0/city",
+	}, pointers(validation.NewProblemFor(input, err, validation.NewCatalog(), validation.LocaleEnglish)))
+
+	// tanpa value, pointer dibuat dari Path dengan nama dari WithFieldNameTag
+	validate, err = validation.New(validation.WithFieldNameTag("json"))
+	assert.Nil(t, err)
+
+	err = validate.StructCtx(context.Background(), ProblemUser{
+		Address: &ProblemAddress{City: "Jakarta"},
+		Emails:  map[string]string{"reo.work": "reo"},
+	})
+	assert.Equal(t, []string{"/emails/reo.work"}, pointers(validation.NewProblem(err, validation.NewCatalog(), validation.LocaleEnglish)))
+}
+
+// TestHTTPProblemDetailsPointer untuk pointer di response problem details memakai nama dari tag json
+func TestHTTPProblemDetailsPointer(t *testing.T) {
+	validate, err := validation.New()
+	assert.Nil(t, err)
+
+	handler := validation.Handler(validate, func(w http.ResponseWriter, r *http.Request, request *ProblemUser) {
+		w.WriteHeader(http.StatusOK)
+	}, validation.WithProblemDetails())
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/users", strings.NewReader(`{"address":{},"emails":{"reo.work":"reo"}}`)))
+
+	var problem validation.Problem
+	assert.Nil(t, json.NewDecoder(recorder.Body).Decode(&problem))
+	assert.Equal(t, []validation.InvalidParam{
+		{Name: "Address.City", Reason: "City is required", Code: "FIELD_REQUIRED", Pointer: "/address/city"},
+		{Name: "Emails[reo.work]", Reason: "Emails[reo.work] must be a valid email address, got 'reo'", Code: "INVALID_EMAIL", Pointer: "/emails/reo.work"},
+	}, problem.InvalidParams)
+}
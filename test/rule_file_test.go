@@ -0,0 +1,151 @@
+package test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"go-validation/validation"
+	"go-validation/validationtest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+type RuleFileRequest struct {
+	Username string `json:"username,omitempty" validate:"required,email"`
+	Password string `json:"password,omitempty" validate:"required,min=6"`
+	Gender   string `json:"gender,omitempty"`
+}
+
+type RuleFileSchedule struct {
+	Timeouts []time.Duration `json:"timeouts,omitempty" validate:"required,dive,min=1s"`
+	Retries  map[string]int  `json:"retries,omitempty"`
+}
+
+// TestRuleFile untuk rules dari file YAML yang mengganti atau menambah tag validate pada struct
+func TestRuleFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rules.yaml")
+	content := "RuleFileRequest:\n  Password: required,min=8\n  gender: omitempty,gender\n"
+	assert.Nil(t, os.WriteFile(path, []byte(content), 0o644))
+
+	types := validation.RuleTypes{"RuleFileRequest": RuleFileRequest{}}
+
+	t.Run("test override rules", func(t *testing.T) {
+		validate, err := validation.New(validation.WithRulesFile(path, validation.RuleLoader{Types: types}))
+		assert.Nil(t, err)
+
+		validationtest.RunStruct(t, validate, []validationtest.StructScenario[RuleFileRequest]{
+			{
+				Name:  "test password from file failed",
+				Input: RuleFileRequest{Username: "reoshby@gmail.com", Password: "1234567", Gender: "mafale"},
+				ExpectErrors: []validationtest.Failure{
+					{Field: "Password", Tag: "min"},
+					{Field: "Gender", Tag: "gender"},
+				},
+			},
+			{
+				Name:  "test password from file success",
+				Input: RuleFileRequest{Username: "reoshby@gmail.com", Password: "12345678"},
+			},
+		})
+	})
+
+	t.Run("test merge rules", func(t *testing.T) {
+		loader := validation.RuleLoader{Types: types, Mode: validation.RuleMerge}
+		set, err := loader.Parse("rules.yaml", []byte("RuleFileRequest:\n  password: max=10\n"))
+		assert.Nil(t, err)
+
+		tag, ok := set.Tag(RuleFileRequest{}, "Password")
+		assert.True(t, ok)
+		assert.Equal(t, "required,min=6,max=10", tag)
+	})
+
+	t.Run("test merge rules before dive", func(t *testing.T) {
+		loader := validation.RuleLoader{Types: validation.RuleTypes{"RuleFileSchedule": RuleFileSchedule{}}, Mode: validation.RuleMerge}
+		set, err := loader.Parse("rules.yaml", []byte("RuleFileSchedule:\n  timeouts: max=5\n"))
+		assert.Nil(t, err)
+
+		tag, ok := set.Tag(RuleFileSchedule{}, "Timeouts")
+		assert.True(t, ok)
+		assert.Equal(t, "required,max=5,dive,min=1s", tag)
+
+		validate, err := validation.New(validation.WithRuleSet(set))
+		assert.Nil(t, err)
+
+		validationtest.RunStruct(t, validate, []validationtest.StructScenario[RuleFileSchedule]{
+			{
+				Name:         "test merge rules before dive failed",
+				Input:        RuleFileSchedule{Timeouts: make([]time.Duration, 6)},
+				ExpectErrors: []validationtest.Failure{{Field: "Timeouts", Tag: "max"}},
+			},
+			{
+				Name:  "test merge rules before dive success",
+				Input: RuleFileSchedule{Timeouts: []time.Duration{time.Second, time.Minute}},
+			},
+		})
+	})
+
+	t.Run("test duration param after dive", func(t *testing.T) {
+		loader := validation.RuleLoader{Types: validation.RuleTypes{"RuleFileSchedule": RuleFileSchedule{}}}
+		_, err := loader.Parse("rules.yaml", []byte("_aliases:\n  positive: min=1s\nRuleFileSchedule:\n  timeouts: required,dive,min=1s\n  retries: dive,keys,min=1,endkeys,min=0\n"))
+		assert.Nil(t, err)
+
+		_, err = loader.Parse("rules.yaml", []byte("_aliases:\n  positive: min=1s\nRuleFileSchedule:\n  timeouts: required,dive,positive\n"))
+		assert.Nil(t, err)
+	})
+
+	t.Run("test eq on string is not a number", func(t *testing.T) {
+		_, err := validation.RuleLoader{Types: types}.Parse("rules.yaml", []byte("RuleFileRequest:\n  gender: omitempty,eq=male|eq=female\n"))
+		assert.Nil(t, err)
+	})
+}
+
+// TestRuleFileInvalid untuk rules yang salah ditolak saat dibaca beserta posisinya
+func TestRuleFileInvalid(t *testing.T) {
+	loader := validation.RuleLoader{Types: validation.RuleTypes{"RuleFileRequest": RuleFileRequest{}, "RuleFileSchedule": RuleFileSchedule{}}}
+
+	scenario := []struct {
+		Name        string
+		Input       string
+		ExpectError string
+	}{
+		{
+			Name:        "test invalid param",
+			Input:       "RuleFileRequest:\n  Password: required,min=abc\n",
+			ExpectError: `rules.yaml:2:13: RuleFileRequest.Password: invalid param "abc" for tag min`,
+		},
+		{
+			Name:        "test invalid duration param after dive",
+			Input:       "RuleFileSchedule:\n  retries: dive,keys,required,endkeys,min=1s\n",
+			ExpectError: `rules.yaml:2:12: RuleFileSchedule.Retries: invalid param "1s" for tag min`,
+		},
+		{
+			Name:        "test invalid duration param from alias",
+			Input:       "_aliases:\n  positive: min=1s\nRuleFileSchedule:\n  retries: dive,positive\n",
+			ExpectError: `rules.yaml:4:12: RuleFileSchedule.Retries: invalid param "1s" for tag min`,
+		},
+		{
+			Name:        "test unknown tag",
+			Input:       "RuleFileRequest:\n  Password: requried\n",
+			ExpectError: `rules.yaml:2:13: RuleFileRequest.Password: invalid tag "requried"`,
+		},
+		{
+			Name:        "test unknown field",
+			Input:       "RuleFileRequest:\n  Email: required\n",
+			ExpectError: `rules.yaml:2:3: type "RuleFileRequest" has no field "Email"`,
+		},
+		{
+			Name:        "test unknown type",
+			Input:       "Register:\n  Email: required\n",
+			ExpectError: `rules.yaml:1:1: type "Register" is not registered`,
+		},
+	}
+
+	for _, s := range scenario {
+		t.Run(s.Name, func(t *testing.T) {
+			_, err := loader.Parse("rules.yaml", []byte(s.Input))
+			if assert.NotNil(t, err) {
+				assert.Contains(t, err.Error(), s.ExpectError)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package test
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"go-validation/validation"
+	"go-validation/validationtest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+type ReloadRequest struct {
+	Email    string `json:"email" validate:"app_email"`
+	Password string `json:"password" validate:"required,min=6"`
+}
+
+// TestRuleReloader untuk validator bersama yang rules nya diganti dari file saat aplikasi berjalan
+// file yang tidak valid ditolak dan rules versi sebelumnya tetap dipakai
+func TestRuleReloader(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rules.yaml")
+	writeRules := func(content string) {
+		assert.Nil(t, os.WriteFile(path, []byte(content), 0o644))
+	}
+
+	writeRules("_aliases:\n  app_email: required,email,min=15\n")
+
+	loader := validation.RuleLoader{Types: validation.RuleTypes{"ReloadRequest": ReloadRequest{}}}
+	reloader, err := validation.NewRuleReloader(path, loader)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, reloader.Version().Number)
+
+	request := ReloadRequest{Email: "reoshby@gmail.com", Password: "1234567"}
+	assert.Nil(t, reloader.StructCtx(context.Background(), request))
+
+	// validasi dari banyak goroutine tetap berjalan selama reload
+	var wait sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wait.Add(1)
+		go func() {
+			defer wait.Done()
+			for j := 0; j < 100; j++ {
+				_ = reloader.Validator().Struct(request)
+			}
+		}()
+	}
+
+	previous := reloader.Validator()
+	assert.NotNil(t, validation.CheckTag(previous, "min_category=x"))
+
+	writeRules("_aliases:\n  app_email: required,email,min=20\nReloadRequest:\n  password: required,min=8\n")
+	assert.Nil(t, reloader.Reload())
+	wait.Wait()
+
+	assert.Equal(t, 2, reloader.Version().Number)
+
+	// validator lama disimpan satu reload lagi untuk validasi yang sedang berjalan
+	assert.NotNil(t, validation.CheckTag(previous, "min_category=x"))
+	assert.NotNil(t, validation.CheckTag(reloader.Validator(), "min_category=x"))
+	validationtest.AssertFailures(t, reloader.StructCtx(context.Background(), request), []validationtest.Failure{
+		{Field: "Email", Tag: "app_email"},
+		{Field: "Password", Tag: "min"},
+	})
+
+	t.Run("test invalid file keeps last good version", func(t *testing.T) {
+		writeRules("ReloadRequest:\n  password: required,min=abc\n")
+		err := reloader.Reload()
+		if assert.NotNil(t, err) {
+			assert.Contains(t, err.Error(), `rules.yaml:2:13: ReloadRequest.Password: invalid param "abc" for tag min`)
+		}
+
+		assert.Equal(t, err, reloader.LastError())
+		assert.Equal(t, 2, reloader.Version().Number)
+		assert.NotNil(t, reloader.StructCtx(context.Background(), ReloadRequest{Email: "reoshby@gmail.com", Password: "12345678"}))
+	})
+
+	t.Run("test watch reloads changed file", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go reloader.Watch(ctx, 10*time.Millisecond)
+
+		writeRules("_aliases:\n  app_email: required,email\n")
+		assert.Eventually(t, func() bool {
+			return reloader.Version().Number == 3
+		}, time.Second, 10*time.Millisecond)
+
+		assert.Nil(t, reloader.LastError())
+		assert.Nil(t, reloader.StructCtx(context.Background(), ReloadRequest{Email: "a@b.co", Password: "123456"}))
+
+		// pengecekan parameter validator versi 1 dihapus supaya tidak menumpuk setiap reload
+		assert.Nil(t, validation.CheckTag(previous, "min_category=x"))
+	})
+}
@@ -0,0 +1,275 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"go-validation/validation"
+	"go-validation/validationtest"
+	"io"
+	"strings"
+	"testing"
+)
+
+// StreamCustomer adalah record dari stream NDJSON atau JSON array
+type StreamCustomer struct {
+	Nama  string `json:"nama" validate:"required,min=2"`
+	Email string `json:"email" validate:"required,email"`
+}
+
+// streamRecord adalah hasil satu record yang dibandingkan di test
+type streamRecord struct {
+	Index  int
+	Offset int64
+	Nama   string
+	Errors []validationtest.Failure
+	Decode bool
+}
+
+// collectStream untuk menjalankan stream validator dan mengambil hasil setiap record
+func collectStream(stream *validation.StreamValidator[StreamCustomer], input string) ([]streamRecord, validation.StreamSummary, error) {
+	var records []streamRecord
+
+	summary, err := stream.Run(context.Background(), strings.NewReader(input), func(result validation.RecordResult[StreamCustomer]) error {
+		record := streamRecord{Index: result.Index, Offset: result.Offset, Nama: result.Value.Nama}
+
+		var validationErrors validator.ValidationErrors
+		if errors.As(result.Err, &validationErrors) {
+			record.Errors = validationtest.Failures(validationErrors)
+		} else if result.Err != nil {
+			record.Decode = true
+		}
+
+		records = append(records, record)
+		return nil
+	})
+
+	return records, summary, err
+}
+
+// TestStreamValidator untuk validasi NDJSON dan JSON array satu record per satu
+func TestStreamValidator(t *testing.T) {
+	validate, err := validation.New()
+	assert.Nil(t, err)
+
+	ndjson := `{"nama":"reo","email":"reo@gmail.com"}
+{"nama":"r","email":"reo"}
+
+{"nama":123,"email":"reo@gmail.com"}
+{"nama":"budi","email":"budi@gmail.com"}
+`
+
+	array := ` [
+  {"nama":"reo","email":"reo@gmail.com"},
+  {"nama":"r","email":"reo"}
+]`
+
+	scenario := []struct {
+		Name          string
+		Input         string
+		MaxErrors     int
+		SkipValid     bool
+		ExpectRecords []streamRecord
+		ExpectSummary validation.StreamSummary
+		ExpectError   string
+	}{
+		{
+			Name:  "test stream ndjson",
+			Input: ndjson,
+			ExpectRecords: []streamRecord{
+				{Index: 0, Offset: 0, Nama: "reo"},
+				{Index: 1, Offset: 39, Nama: "r", Errors: []validationtest.Failure{{Field: "Nama", Tag: "min"}, {Field: "Email", Tag: "email"}}},
+				{Index: 2, Offset: 67, Decode: true},
+				{Index: 3, Offset: 104, Nama: "budi"},
+			},
+			ExpectSummary: validation.StreamSummary{Records: 4, Invalid: 2},
+		},
+		{
+			Name:      "test stream ndjson hanya record tidak valid",
+			Input:     ndjson,
+			SkipValid: true,
+			ExpectRecords: []streamRecord{
+				{Index: 1, Offset: 39, Nama: "r", Errors: []validationtest.Failure{{Field: "Nama", Tag: "min"}, {Field: "Email", Tag: "email"}}},
+				{Index: 2, Offset: 67, Decode: true},
+			},
+			ExpectSummary: validation.StreamSummary{Records: 4, Invalid: 2},
+		},
+		{
+			Name:  "test stream json array",
+			Input: array,
+			ExpectRecords: []streamRecord{
+				{Index: 0, Offset: 5, Nama: "reo"},
+				{Index: 1, Offset: 47, Nama: "r", Errors: []validationtest.Failure{{Field: "Nama", Tag: "min"}, {Field: "Email", Tag: "email"}}},
+			},
+			ExpectSummary: validation.StreamSummary{Records: 2, Invalid: 1},
+		},
+		{
+			Name:      "test stream max errors",
+			Input:     ndjson,
+			MaxErrors: 1,
+			ExpectRecords: []streamRecord{
+				{Index: 0, Offset: 0, Nama: "reo"},
+				{Index: 1, Offset: 39, Nama: "r", Errors: []validationtest.Failure{{Field: "Nama", Tag: "min"}, {Field: "Email", Tag: "email"}}},
+			},
+			ExpectSummary: validation.StreamSummary{Records: 2, Invalid: 1, Truncated: true},
+		},
+		{
+			Name:      "test stream max errors di record terakhir",
+			Input:     array,
+			MaxErrors: 1,
+			ExpectRecords: []streamRecord{
+				{Index: 0, Offset: 5, Nama: "reo"},
+				{Index: 1, Offset: 47, Nama: "r", Errors: []validationtest.Failure{{Field: "Nama", Tag: "min"}, {Field: "Email", Tag: "email"}}},
+			},
+			ExpectSummary: validation.StreamSummary{Records: 2, Invalid: 1},
+		},
+		{
+			Name:          "test stream kosong",
+			Input:         " \n",
+			ExpectSummary: validation.StreamSummary{},
+		},
+		{
+			Name:          "test stream json rusak",
+			Input:         "{\"nama\":\"reo\",\"email\":\"reo@gmail.com\"}\n{\"nama\":",
+			ExpectRecords: []streamRecord{{Index: 0, Offset: 0, Nama: "reo"}},
+			ExpectSummary: validation.StreamSummary{Records: 1},
+			ExpectError:   "record 1: unexpected EOF",
+		},
+		{
+			Name:        "test stream data setelah array",
+			Input:       `[] {}`,
+			ExpectError: "record 0: unexpected data after JSON array",
+		},
+	}
+
+	for _, testScenario := range scenario {
+		t.Run(testScenario.Name, func(t *testing.T) {
+			stream := validation.NewStreamValidator[StreamCustomer](validate)
+			stream.MaxErrors = testScenario.MaxErrors
+			stream.SkipValid = testScenario.SkipValid
+
+			records, summary, err := collectStream(stream, testScenario.Input)
+			if testScenario.ExpectError != "" {
+				assert.EqualError(t, err, testScenario.ExpectError)
+			} else {
+				assert.Nil(t, err)
+			}
+
+			assert.Equal(t, testScenario.ExpectRecords, records)
+			assert.Equal(t, testScenario.ExpectSummary, summary)
+
+			// offset menunjuk ke awal record di input
+			for _, record := range records {
+				assert.Equal(t, byte('{'), testScenario.Input[record.Offset])
+			}
+		})
+	}
+}
+
+// TestStreamValidatorPointer untuk T berupa pointer ke struct divalidasi sama seperti struct nya
+// record null tidak menghentikan stream, hanya dianggap tidak valid
+func TestStreamValidatorPointer(t *testing.T) {
+	validate, err := validation.New()
+	assert.Nil(t, err)
+
+	input := `{"nama":"reo","email":"reo@gmail.com"}
+{"nama":"r","email":"reo"}
+null
+`
+
+	var results []validation.RecordResult[*StreamCustomer]
+	summary, err := validation.NewStreamValidator[*StreamCustomer](validate).Run(context.Background(), strings.NewReader(input), func(result validation.RecordResult[*StreamCustomer]) error {
+		results = append(results, result)
+		return nil
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, validation.StreamSummary{Records: 3, Invalid: 2}, summary)
+	if assert.Len(t, results, 3) {
+		assert.Nil(t, results[0].Err)
+		assert.Equal(t, "reo", results[0].Value.Nama)
+
+		var validationErrors validator.ValidationErrors
+		if assert.ErrorAs(t, results[1].Err, &validationErrors) {
+			assert.Equal(t, []validationtest.Failure{
+				{Field: "Nama", Tag: "min"},
+				{Field: "Email", Tag: "email"},
+			}, validationtest.Failures(validationErrors))
+		}
+
+		assert.ErrorIs(t, results[2].Err, validation.ErrNullRecord)
+		assert.Nil(t, results[2].Value)
+	}
+}
+
+// TestStreamValidatorMaxRecordSize untuk record yang lebih besar dari MaxRecordSize menghentikan stream
+func TestStreamValidatorMaxRecordSize(t *testing.T) {
+	validate, err := validation.New()
+	assert.Nil(t, err)
+
+	stream := validation.NewStreamValidator[StreamCustomer](validate)
+	stream.MaxRecordSize = 4096
+
+	input := `{"nama":"reo","email":"reo@gmail.com"}` + "\n" + `{"nama":"` + strings.Repeat("a", 1<<20) + `"}`
+	records, summary, err := collectStream(stream, input)
+
+	assert.ErrorIs(t, err, validation.ErrRecordTooLarge)
+	assert.Len(t, records, 1)
+	assert.Equal(t, 1, summary.Records)
+}
+
+// TestStreamValidatorResults untuk hasil stream dari channel
+func TestStreamValidatorResults(t *testing.T) {
+	validate, err := validation.New()
+	assert.Nil(t, err)
+
+	var input strings.Builder
+	for i := 0; i < 1000; i++ {
+		input.WriteString(`{"nama":"reo","email":"reo"}` + "\n")
+	}
+
+	stream := validation.NewStreamValidator[StreamCustomer](validate)
+	results, wait := stream.Results(context.Background(), strings.NewReader(input.String()))
+
+	count := 0
+	for result := range results {
+		assert.Equal(t, count, result.Index)
+		assert.Equal(t, int64(count*29), result.Offset)
+		assert.Error(t, result.Err)
+		count++
+	}
+
+	summary, err := wait()
+	assert.Nil(t, err)
+	assert.Equal(t, 1000, count)
+	assert.Equal(t, validation.StreamSummary{Records: 1000, Invalid: 1000}, summary)
+}
+
+// TestStreamValidatorCancel untuk stream berhenti saat context dibatalkan
+func TestStreamValidatorCancel(t *testing.T) {
+	validate, err := validation.New()
+	assert.Nil(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	reader, writer := io.Pipe()
+	go func() {
+		for {
+			if _, err := writer.Write([]byte(`{"nama":"reo","email":"reo@gmail.com"}` + "\n")); err != nil {
+				return
+			}
+		}
+	}()
+	defer reader.Close()
+
+	stream := validation.NewStreamValidator[StreamCustomer](validate)
+	results, wait := stream.Results(ctx, reader)
+
+	<-results
+	cancel()
+
+	summary, err := wait()
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Greater(t, summary.Records, 0)
+}
@@ -0,0 +1,129 @@
+package test
+
+import (
+	"context"
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"go-validation/validation"
+	"strings"
+	"testing"
+)
+
+// TestSuggestion untuk saran value ("did you mean") pada tag set dan email
+// saran hanya muncul jika catalog punya suggester
+func TestSuggestion(t *testing.T) {
+	validate, err := validation.New()
+	assert.Nil(t, err)
+
+	catalog := validation.NewCatalog()
+	catalog.SetSuggester(validation.NewSuggester())
+
+	scenario := []struct {
+		Name             string
+		Input            string
+		Tag              string
+		Locale           string
+		ExpectSuggestion string
+		ExpectMessage    string
+	}{
+		{
+			Name:             "test suggestion gender",
+			Input:            "mafale",
+			Tag:              "gender",
+			Locale:           validation.LocaleEnglish,
+			ExpectSuggestion: "male",
+			ExpectMessage:    "value must be one of [male female], got 'mafale', did you mean 'male'?",
+		},
+		{
+			Name:             "test suggestion inset category indonesian",
+			Input:            "gadgte",
+			Tag:              "inset=category",
+			Locale:           validation.LocaleIndonesian,
+			ExpectSuggestion: "gadget",
+			ExpectMessage:    "nilai harus salah satu dari [hobby gadget adventure automotive], bukan 'gadgte', mungkin maksud anda 'gadget'?",
+		},
+		{
+			Name:             "test suggestion oneof",
+			Input:            "gren",
+			Tag:              "oneof=red green blue",
+			Locale:           validation.LocaleEnglish,
+			ExpectSuggestion: "green",
+			ExpectMessage:    "value must be one of [red green blue], got 'gren', did you mean 'green'?",
+		},
+		{
+			Name:             "test suggestion email domain",
+			Input:            "reoshby@gmail,com",
+			Tag:              "app_email",
+			Locale:           validation.LocaleEnglish,
+			ExpectSuggestion: "reoshby@gmail.com",
+			ExpectMessage:    "value must be a valid email address, got 'reoshby@gmail,com', did you mean 'reoshby@gmail.com'?",
+		},
+		{
+			Name:          "test no suggestion when email valid but too short",
+			Input:         "reo@mail.com",
+			Tag:           "app_email",
+			Locale:        validation.LocaleEnglish,
+			ExpectMessage: "value must be at least 15 characters",
+		},
+		{
+			Name:          "test no suggestion when too far",
+			Input:         "sleeping",
+			Tag:           "category",
+			Locale:        validation.LocaleEnglish,
+			ExpectMessage: "value must be one of [hobby gadget adventure automotive], got 'sleeping'",
+		},
+		{
+			Name:          "test no suggestion for unknown domain",
+			Input:         "reo@company",
+			Tag:           "email",
+			Locale:        validation.LocaleEnglish,
+			ExpectMessage: "value must be a valid email address, got 'reo@company'",
+		},
+	}
+
+	for _, testScenario := range scenario {
+		t.Run(testScenario.Name, func(t *testing.T) {
+			err := validate.VarCtx(context.Background(), testScenario.Input, testScenario.Tag)
+
+			fieldErrors := validation.FieldErrors(err, catalog, testScenario.Locale)
+			if assert.Len(t, fieldErrors, 1) {
+				assert.Equal(t, testScenario.ExpectSuggestion, fieldErrors[0].Suggestion)
+				assert.Equal(t, testScenario.ExpectMessage, fieldErrors[0].Message)
+			}
+		})
+	}
+}
+
+// TestSuggestionDisabled untuk catalog tanpa suggester tidak memberi saran
+func TestSuggestionDisabled(t *testing.T) {
+	validate, err := validation.New()
+	assert.Nil(t, err)
+
+	err = validate.VarCtx(context.Background(), "mafale", "gender")
+	fieldErrors := validation.FieldErrors(err, validation.NewCatalog(), validation.LocaleEnglish)
+	if assert.Len(t, fieldErrors, 1) {
+		assert.Empty(t, fieldErrors[0].Suggestion)
+		assert.Equal(t, "value must be one of [male female], got 'mafale'", fieldErrors[0].Message)
+	}
+}
+
+// TestSuggestionCustomDomains untuk daftar domain email dan tag email yang bisa diganti
+func TestSuggestionCustomDomains(t *testing.T) {
+	validate, err := validation.New(validation.WithValidation("work_email", func(field validator.FieldLevel) bool {
+		return strings.HasSuffix(field.Field().String(), "@company.co.id")
+	}))
+	assert.Nil(t, err)
+
+	suggester := validation.NewSuggester()
+	suggester.Domains = []string{"company.co.id"}
+	suggester.EmailTags = append(suggester.EmailTags, "work_email")
+
+	catalog := validation.NewCatalog()
+	catalog.SetSuggester(suggester)
+
+	err = validate.VarCtx(context.Background(), "reo@compnay.co.id", "work_email")
+	fieldErrors := validation.FieldErrors(err, catalog, validation.LocaleEnglish)
+	if assert.Len(t, fieldErrors, 1) {
+		assert.Equal(t, "reo@company.co.id", fieldErrors[0].Suggestion)
+	}
+}
@@ -0,0 +1,35 @@
+package tags
+
+import "time"
+
+type Address struct {
+	City string `json:"city" validate:"required"`
+}
+
+type Request struct {
+	Username   string            `json:"username" validate:"requried,email"`               // want `field Username: unknown tag "requried"`
+	Password   string            `json:"password" validate:"required,min=abc"`             // want `field Password: invalid param "abc" for tag min on string`
+	Confirm    string            `json:"confirm" validate:"required,eqfield=Pasword"`      // want `field Confirm: tag eqfield refers to missing field "Pasword"`
+	Name       string            `json:"name" validate:"required,dive,min=2"`              // want `field Name: dive on type string, want slice, array or map`
+	Emails     map[string]string `json:"emails" validate:"dive,keys,min=3,email"`          // want `field Emails: keys without endkeys`
+	Hobbies    []string          `json:"hobbies" validate:"required,min_category=x"`       // want `field Hobbies: invalid int param "x" for tag min_category: .*`
+	Servers    []string          `json:"servers" validate:"required,keys,ip,endkeys"`      // want `field Servers: keys must directly follow dive on a map`
+	Timeout    time.Duration     `json:"timeout" validate:"required,max=1day"`             // want `field Timeout: invalid param "1day" for tag max on time.Duration`
+	Retry      int               `json:"retry" validate:"omitempty,required_if=Mode fast"` // want `field Retry: tag required_if refers to missing field "Mode"`
+	Categories []string          `json:"categories" validate:"dive,category|inset=colour"`
+	Addresses  []Address         `json:"addresses" validate:"required,dive"`
+}
+
+type Valid struct {
+	Email    string            `json:"email" validate:"app_email"`
+	Password string            `json:"password" validate:"required,min=6,max=20"`
+	Confirm  string            `json:"confirm" validate:"required,eqfield=Password"`
+	Status   string            `json:"status" validate:"required,eq=active|eq=inactive"`
+	Gender   string            `json:"gender" validate:"omitempty,gender"`
+	Hobbies  []string          `json:"hobbies" validate:"required,min=1,dive,category"`
+	Emails   map[string]string `json:"emails" validate:"dive,keys,min=3,endkeys,email"`
+	Timeout  time.Duration     `json:"timeout" validate:"required,max=24h"`
+	Address  Address           `json:"address" validate:"required"`
+	City     string            `json:"city" validate:"required_with=Address.City"`
+	Ignored  string            `json:"ignored" validate:"-"`
+}
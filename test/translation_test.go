@@ -0,0 +1,181 @@
+package test
+
+import (
+	"context"
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"go-validation/validation"
+	"log"
+	"strings"
+	"testing"
+)
+
+// TestTranslateMessages untuk membuat message sesuai locale yang dipilih saat dipanggil
+// locale yang tersedia : en dan id, locale lain akan memakai en
+func TestTranslateMessages(t *testing.T) {
+	validate, err := validation.New()
+	assert.Nil(t, err)
+
+	catalog := validation.NewCatalog()
+
+	type Address struct {
+		City string `json:"city,omitempty" validate:"required"`
+	}
+
+	type User struct {
+		Nama    string   `json:"nama,omitempty" validate:"required,min=2"`
+		Gender  string   `json:"gender,omitempty" validate:"gender"`
+		Address *Address `json:"address,omitempty" validate:"required"`
+	}
+
+	input := User{
+		Nama:    "r",
+		Gender:  "mafale",
+		Address: &Address{},
+	}
+
+	scenario := []struct {
+		Name           string
+		Locale         string
+		ExpectMessages []string
+	}{
+		{
+			Name:   "test translate bahasa indonesia",
+			Locale: validation.LocaleIndonesian,
+			ExpectMessages: []string{
+				"Nama minimal 2 karakter",
+				"Gender harus salah satu dari [male female], bukan 'mafale'",
+				"City wajib diisi",
+			},
+		},
+		{
+			Name:   "test translate bahasa indonesia dengan region",
+			Locale: "id-ID",
+			ExpectMessages: []string{
+				"Nama minimal 2 karakter",
+				"Gender harus salah satu dari [male female], bukan 'mafale'",
+				"City wajib diisi",
+			},
+		},
+		{
+			Name:   "test translate bahasa indonesia dengan region underscore",
+			Locale: "id_ID",
+			ExpectMessages: []string{
+				"Nama minimal 2 karakter",
+				"Gender harus salah satu dari [male female], bukan 'mafale'",
+				"City wajib diisi",
+			},
+		},
+		{
+			Name:   "test translate bahasa inggris",
+			Locale: validation.LocaleEnglish,
+			ExpectMessages: []string{
+				"Nama must be at least 2 characters",
+				"Gender must be one of [male female], got 'mafale'",
+				"City is required",
+			},
+		},
+		{
+			Name:   "test translate locale tidak didukung memakai bahasa inggris",
+			Locale: "fr",
+			ExpectMessages: []string{
+				"Nama must be at least 2 characters",
+				"Gender must be one of [male female], got 'mafale'",
+				"City is required",
+			},
+		},
+	}
+
+	err = validate.StructCtx(context.Background(), input)
+	assert.NotNil(t, err)
+
+	for _, testScenario := range scenario {
+		t.Run(testScenario.Name, func(t *testing.T) {
+			messages := catalog.TranslateAll(err, testScenario.Locale)
+			for _, message := range messages {
+				log.Println(message)
+			}
+
+			assert.Equal(t, testScenario.ExpectMessages, messages)
+		})
+	}
+}
+
+// TestTranslateVariable untuk validasi variabel yang tidak punya nama field
+// nama field diganti dengan nama default sesuai locale
+func TestTranslateVariable(t *testing.T) {
+	validate, err := validation.New(validation.WithValidation("no_space", func(fl validator.FieldLevel) bool {
+		return !strings.Contains(fl.Field().String(), " ")
+	}))
+	assert.Nil(t, err)
+
+	catalog := validation.NewCatalog()
+
+	scenario := []struct {
+		Name          string
+		Input         string
+		Tag           string
+		Locale        string
+		ExpectMessage []string
+	}{
+		{
+			Name:          "test translate alias bahasa indonesia",
+			Input:         "reoo",
+			Tag:           "app_email",
+			Locale:        validation.LocaleIndonesian,
+			ExpectMessage: []string{"nilai harus berupa alamat email yang valid, bukan 'reoo'"},
+		},
+		{
+			Name:          "test translate tag bawaan validator bahasa indonesia",
+			Input:         "abc",
+			Tag:           "uppercase",
+			Locale:        validation.LocaleIndonesian,
+			ExpectMessage: []string{"nilai harus berupa huruf besar"},
+		},
+		{
+			Name:          "test translate tag tanpa message bahasa indonesia",
+			Input:         "reo sahobby",
+			Tag:           "no_space",
+			Locale:        validation.LocaleIndonesian,
+			ExpectMessage: []string{"nilai tidak valid"},
+		},
+		{
+			Name:          "test translate ip bahasa inggris",
+			Input:         "172.www",
+			Tag:           "ip",
+			Locale:        validation.LocaleEnglish,
+			ExpectMessage: []string{"value must be a valid IP address, got '172.www'"},
+		},
+	}
+
+	for _, testScenario := range scenario {
+		t.Run(testScenario.Name, func(t *testing.T) {
+			err := validate.VarCtx(context.Background(), testScenario.Input, testScenario.Tag)
+
+			assert.Equal(t, testScenario.ExpectMessage, catalog.TranslateAll(err, testScenario.Locale))
+		})
+	}
+}
+
+// TestRegisterLocale untuk menambahkan message pada locale tertentu
+func TestRegisterLocale(t *testing.T) {
+	validate, err := validation.New()
+	assert.Nil(t, err)
+
+	catalog := validation.NewCatalog()
+	assert.Nil(t, catalog.RegisterLocale(validation.LocaleIndonesian, "uppercase", "{field} harus huruf besar"))
+	assert.NotNil(t, catalog.RegisterLocale("fr", "uppercase", "{field} doit être en majuscules"))
+
+	err = validate.VarCtx(context.Background(), "abc", "uppercase")
+	assert.Equal(t, []string{"nilai harus huruf besar"}, catalog.TranslateAll(err, validation.LocaleIndonesian))
+	assert.Equal(t, []string{"value must be uppercase"}, catalog.TranslateAll(err, validation.LocaleEnglish))
+}
+
+// TestMissingTranslations untuk memastikan semua message bahasa inggris sudah diterjemahkan
+func TestMissingTranslations(t *testing.T) {
+	catalog := validation.NewCatalog()
+
+	assert.Empty(t, catalog.MissingTranslations(validation.LocaleIndonesian))
+	assert.Contains(t, catalog.Tags(), validation.TagGender)
+	assert.Contains(t, catalog.Tags(), "min")
+}
@@ -0,0 +1,200 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"go-validation/test/model"
+	"go-validation/validategen"
+	"go-validation/validation"
+	"os"
+	"reflect"
+	"testing"
+)
+
+// generatedTypes sama dengan -type di go:generate pada test/model/model.go
+var generatedTypes = []string{
+	"Customer", "LoginRequest", "LoginRequet", "User", "Member", "Server", "Directory", "Contacts", "Profile", "Credential",
+}
+
+// generatedValidator adalah struct yang punya method Validate hasil validategen
+type generatedValidator interface {
+	Validate(ctx context.Context) error
+}
+
+// generatedFailure adalah isi FieldError yang dibandingkan antara validator dan kode hasil generate
+type generatedFailure struct {
+	Namespace       string
+	StructNamespace string
+	Field           string
+	Tag             string
+	ActualTag       string
+	Param           string
+	Kind            reflect.Kind
+	Type            reflect.Type
+	Value           any
+	Error           string
+}
+
+// generatedFailures untuk mengubah error validasi menjadi generatedFailure
+func generatedFailures(t *testing.T, err error) []generatedFailure {
+	if err == nil {
+		return nil
+	}
+
+	var validationErrors validator.ValidationErrors
+	if !assert.True(t, errors.As(err, &validationErrors), "error %T bukan validator.ValidationErrors", err) {
+		return nil
+	}
+
+	var failures []generatedFailure
+	for _, fieldError := range validationErrors {
+		failures = append(failures, generatedFailure{
+			Namespace:       fieldError.Namespace(),
+			StructNamespace: fieldError.StructNamespace(),
+			Field:           fieldError.Field(),
+			Tag:             fieldError.Tag(),
+			ActualTag:       fieldError.ActualTag(),
+			Param:           fieldError.Param(),
+			Kind:            fieldError.Kind(),
+			Type:            fieldError.Type(),
+			Value:           fieldError.Value(),
+			Error:           fieldError.Error(),
+		})
+	}
+	return failures
+}
+
+// TestValidateGenEquivalence untuk memastikan method Validate hasil validategen sama dengan validate.StructCtx
+// skenario diambil dari validation_struct_test.go, urutan error map boleh berbeda karena urutan map acak
+func TestValidateGenEquivalence(t *testing.T) {
+	validate, err := validation.New()
+	assert.Nil(t, err)
+
+	nickname, longNickname := "reo", "reo_shby_1299"
+
+	scenario := []struct {
+		Name  string
+		Input generatedValidator
+	}{
+		{Name: "test customer success", Input: &model.Customer{Nama: "reo"}},
+		{Name: "test customer failed", Input: &model.Customer{Nama: "n"}},
+		{Name: "test customer empty", Input: &model.Customer{}},
+		{Name: "test login success", Input: &model.LoginRequest{Username: "reo@gmail.com", Password: "rahasia"}},
+		{Name: "test login failed", Input: &model.LoginRequest{Username: "reo", Password: "123"}},
+		{Name: "test login empty", Input: &model.LoginRequest{}},
+		{Name: "test validation errors failed", Input: &model.LoginRequet{Username: "a@b", Password: "abc123"}},
+		{Name: "test validation errors alpha success", Input: &model.LoginRequet{Username: "reo@gmail.com", Password: "rahasia"}},
+		{Name: "test nested struct success", Input: &model.User{Name: "reo", Address: &model.Address{City: "Jakarta", Country: "Indonesia"}}},
+		{Name: "test nested struct nil", Input: &model.User{Name: "reo"}},
+		{Name: "test nested struct failed", Input: &model.User{Address: &model.Address{}}},
+		{Name: "test slice success", Input: &model.Member{Name: "reo", Addresses: []model.MemberAddress{{City: "Jakarta", Country: "ID"}}}},
+		{Name: "test slice failed", Input: &model.Member{Name: "reo", Addresses: []model.MemberAddress{{City: "J", Country: ""}, {City: "Bandung", Country: "I"}}}},
+		{Name: "test slice nil", Input: &model.Member{}},
+		{Name: "test basic slice success", Input: &model.Server{Name: "db", IPAddresses: []string{"172.18.10.22", "::1"}}},
+		{Name: "test basic slice failed", Input: &model.Server{Name: "db", IPAddresses: []string{"172.18.10", ""}}},
+		{Name: "test map failed", Input: &model.Directory{Schools: map[string]*model.School{"s": {Name: "a", Address: "a"}, "sd": nil}}},
+		{Name: "test map success", Input: &model.Directory{Schools: map[string]*model.School{"sd": {Name: "SD N 1", Address: "Jakarta Selatan"}}}},
+		{
+			Name: "test basic map failed",
+			Input: &model.Contacts{
+				Emails:  map[string]string{"user1": "user1", "user2": "user2@gmail.com", "user3": "", "a": "reo@gmail.com"},
+				Servers: map[string]string{"": "172.18.10.22", "server2": "localhost"},
+			},
+		},
+		{
+			Name: "test basic map success",
+			Input: &model.Contacts{
+				Emails:  map[string]string{"user2": "user2@gmail.com"},
+				Servers: map[string]string{"server1": "172.18.10.22"},
+			},
+		},
+		{
+			Name: "test custom tag success",
+			Input: &model.Profile{
+				Email: "reoshby1299@gmail.com", Category: "gadget", Gender: "male", Categories: []string{"a", "b", "c"},
+				Status: "active", Age: 25, Nickname: &nickname,
+			},
+		},
+		{
+			Name: "test custom tag failed",
+			Input: &model.Profile{
+				Email: "reoo", Category: "abcd", Gender: "mafale", Categories: []string{"r", "e", "o"},
+				Status: "deleted", Age: 70, Nickname: &longNickname,
+			},
+		},
+		{Name: "test custom tag empty", Input: &model.Profile{}},
+		{Name: "test field tag success", Input: &model.Credential{Password: "rahasia", Confirm: "rahasia", Previous: "lama123", Login: "08123"}},
+		{Name: "test field tag failed", Input: &model.Credential{Password: "rahasia", Confirm: "rahasi", Previous: "rahasia", Login: "reo"}},
+	}
+
+	for _, testScenario := range scenario {
+		t.Run(testScenario.Name, func(t *testing.T) {
+			expected := validate.StructCtx(context.Background(), testScenario.Input)
+			actual := testScenario.Input.Validate(context.Background())
+
+			assert.Equal(t, expected == nil, actual == nil)
+			assert.ElementsMatch(t, generatedFailures(t, expected), generatedFailures(t, actual))
+			assert.ElementsMatch(t, validation.NewCatalog().Messages(expected), validation.NewCatalog().Messages(actual))
+		})
+	}
+}
+
+// TestValidateGenNil untuk receiver nil mengembalikan InvalidValidationError seperti validate.StructCtx
+func TestValidateGenNil(t *testing.T) {
+	validate, err := validation.New()
+	assert.Nil(t, err)
+
+	var user *model.User
+	assert.Equal(t, validate.StructCtx(context.Background(), user), user.Validate(context.Background()))
+}
+
+// TestValidateGenUpToDate untuk memastikan file hasil generate sudah sesuai dengan struct di test/model
+func TestValidateGenUpToDate(t *testing.T) {
+	source, err := validategen.Generate(validategen.Config{Dir: "model", Types: generatedTypes, Output: "model_validate.go"})
+	assert.Nil(t, err)
+
+	committed, err := os.ReadFile("model/model_validate.go")
+	assert.Nil(t, err)
+	assert.Equal(t, string(committed), string(source), "jalankan go generate ./test/model")
+}
+
+// TestValidateGenUnsupported untuk tag yang tidak didukung membuat Generate gagal, bukan menghasilkan kode yang salah
+func TestValidateGenUnsupported(t *testing.T) {
+	_, err := validategen.Generate(validategen.Config{Dir: "testdata/src/tags", Types: []string{"Valid"}})
+	assert.ErrorContains(t, err, "Valid.City: tag required_with is not supported by validategen")
+}
+
+// TestGeneratedHelpers untuk function pengecekan yang dipakai kode hasil validategen harus sama dengan tag bawaan validator
+// terutama untuk value yang jarang dipakai, misal email dengan quote, unicode atau titik di akhir domain
+func TestGeneratedHelpers(t *testing.T) {
+	validate := validator.New()
+
+	helpers := map[string]func(value string) bool{
+		"email":    validation.IsEmail,
+		"alpha":    validation.IsAlpha,
+		"alphanum": validation.IsAlphanum,
+		"numeric":  validation.IsNumeric,
+		"ip":       validation.IsIP,
+		"ipv4":     validation.IsIPv4,
+		"ipv6":     validation.IsIPv6,
+	}
+
+	values := []string{
+		"", " ", "reo@gmail.com", "REO.SHBY+tag@mail.co.id", "reo@gmail.com.", "reo@gmail..com", "reo@-gmail.com",
+		"reo@gmail-.com", "reo@localhost", "reo@127.0.0.1", "reo@[127.0.0.1]", "reo.@gmail.com", ".reo@gmail.com",
+		"re..o@gmail.com", `"reo shby"@gmail.com`, `"reo@shby"@gmail.com`, `"reo\"shby"@gmail.com`, "reo@gmail.c0m",
+		"rëo@gmail.com", "reo@gmaïl.com", "reo@例え.テスト", "reo@gmail.com\n", "reo @gmail.com", "reo@@gmail.com",
+		"!#$%&'*+-/=?^_`{|}~@gmail.com", "reo@g.m.a.i.l", "reo@gmail.com~", "abc", "ABCdef", "abc123", "abc 123",
+		"ábc", "123", "-1.5", "+10", "1.", ".5", "1e5", "0x1F", "127.0.0.1", "256.0.0.1", "::1", "::ffff:127.0.0.1",
+		"2001:db8::1", "2001:db8::g", "1.2.3", "01.02.03.04",
+	}
+
+	for tag, helper := range helpers {
+		for _, value := range values {
+			expected := validate.Var(value, tag) == nil
+			assert.Equal(t, expected, helper(value), "tag %s value %q", tag, value)
+		}
+	}
+}
@@ -0,0 +1,13 @@
+package test
+
+import (
+	"go-validation/validatetag"
+	"golang.org/x/tools/go/analysis/analysistest"
+	"testing"
+)
+
+// TestValidateTagAnalyzer untuk mengecek analyzer melaporkan tag validate yang salah saat compile
+// expected diagnostic ditulis dengan komentar want di testdata/src/tags
+func TestValidateTagAnalyzer(t *testing.T) {
+	analysistest.Run(t, analysistest.TestData(), validatetag.Analyzer, "tags")
+}
@@ -0,0 +1,85 @@
+package test
+
+import (
+	"context"
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"go-validation/validation"
+	"log"
+	"testing"
+)
+
+// TestNewWithOption untuk menambahkan custom tag dan alias lain saat membuat validator
+// contoh : validation.New(validation.WithValidation(tag, fn), validation.WithAlias(alias, tags))
+func TestNewWithOption(t *testing.T) {
+	validateEven := func(field validator.FieldLevel) bool {
+		return field.Field().Int()%2 == 0
+	}
+
+	validate, err := validation.New(
+		validation.WithValidation("even", validateEven),
+		validation.WithAlias("app_age", "required,even,min=18"),
+	)
+	assert.Nil(t, err)
+
+	scenario := []struct {
+		Name        string
+		Input       int
+		Tag         string
+		ExpectError bool
+	}{
+		{
+			Name:        "test validasi option tag failed",
+			Input:       3,
+			Tag:         "even",
+			ExpectError: true,
+		},
+		{
+			Name:        "test validasi option tag success",
+			Input:       4,
+			Tag:         "even",
+			ExpectError: false,
+		},
+		{
+			Name:        "test validasi option alias failed",
+			Input:       16,
+			Tag:         "app_age",
+			ExpectError: true,
+		},
+		{
+			Name:        "test validasi option alias success",
+			Input:       20,
+			Tag:         "app_age",
+			ExpectError: false,
+		},
+		{
+			Name:        "test validasi default tag tetap terdaftar",
+			Input:       20,
+			Tag:         "required,min=18",
+			ExpectError: false,
+		},
+	}
+
+	for _, testScenario := range scenario {
+		t.Run(testScenario.Name, func(t *testing.T) {
+			err := validate.VarCtx(context.Background(), testScenario.Input, testScenario.Tag)
+			if err != nil {
+				for _, errorField := range err.(validator.ValidationErrors) {
+					log.Println(errorField.Error())
+				}
+			}
+
+			assert.Equal(t, err != nil, testScenario.ExpectError)
+		})
+	}
+}
+
+// TestNewWithOptionError untuk mengecek error saat option gagal didaftarkan
+func TestNewWithOptionError(t *testing.T) {
+	validate, err := validation.New(validation.WithValidation("", func(field validator.FieldLevel) bool {
+		return true
+	}))
+
+	assert.NotNil(t, err)
+	assert.Nil(t, validate)
+}
@@ -2,287 +2,178 @@ package test
 
 import (
 	"context"
-	"github.com/go-playground/validator/v10"
 	"github.com/stretchr/testify/assert"
+	"go-validation/validation"
+	"go-validation/validationtest"
 	"log"
-	"slices"
-	"strconv"
 	"testing"
 )
 
 func TestValidationStruct(t *testing.T) {
-	validate := validator.New()
+	validate, err := validation.New()
+	assert.Nil(t, err)
 
 	type Customer struct {
 		Nama string `json:"nama,omitempty" validate:"required,min=2"`
 	}
 
-	scenario := []struct {
-		Name             string
-		Input            Customer
-		ExpectedErrorNil bool
-	}{
+	validationtest.RunStruct(t, validate, []validationtest.StructScenario[Customer]{
 		{
-			Name:             "test success validation",
-			Input:            Customer{"reo"},
-			ExpectedErrorNil: true,
+			Name:  "test success validation",
+			Input: Customer{"reo"},
 		},
 		{
-			Name:             "test failed validation",
-			Input:            Customer{Nama: "n"},
-			ExpectedErrorNil: false,
+			Name:         "test failed validation",
+			Input:        Customer{Nama: "n"},
+			ExpectErrors: []validationtest.Failure{{Field: "Nama", Tag: "min"}},
 		},
-	}
-
-	for _, testScenario := range scenario {
-		ctx := context.Background()
-		t.Run(testScenario.Name, func(t *testing.T) {
-			err := validate.StructCtx(ctx, testScenario.Input)
-
-			assert.Equal(t, err == nil, testScenario.ExpectedErrorNil)
-		})
-	}
+	})
 }
 
 func TestValidateVariable(t *testing.T) {
-	validate := validator.New()
+	validate, err := validation.New()
+	assert.Nil(t, err)
 
-	scenario := []struct {
-		Name          string
-		Input         string
-		Tag           string
-		ExpectedError bool
-	}{
+	validationtest.RunVar(t, validate, []validationtest.VarScenario[string]{
 		{
-			Name:          "test required failed",
-			Input:         "",
-			Tag:           "required",
-			ExpectedError: true,
+			Name:         "test required failed",
+			Input:        "",
+			Tag:          "required",
+			ExpectErrors: []validationtest.Failure{{Tag: "required"}},
 		},
 		{
-			Name:          "test required success validate",
-			Input:         "reo",
-			Tag:           "required",
-			ExpectedError: false,
+			Name:  "test required success validate",
+			Input: "reo",
+			Tag:   "required",
 		},
-	}
-
-	for _, scenarioTest := range scenario {
-		t.Run(scenarioTest.Name, func(t *testing.T) {
-			ctx := context.Background()
-			err := validate.VarCtx(ctx, scenarioTest.Input, scenarioTest.Tag)
-			if err != nil {
-				errorField := err.(validator.ValidationErrors)
-				log.Printf("error with tag [%v]", errorField[0].Tag())
-			}
-
-			assert.Equal(t, err != nil, scenarioTest.ExpectedError)
-		})
-	}
+	})
 }
 
 // TestValidasiDuaVariable untuk validasi pada 2 variabel
 // misal saat kita melakukan validasi password dan confirmPassword
 // contoh : validate.VarWithValue(password, confirmPassword, "eqfield")
+// isi Other pada skenario agar validationtest memakai VarWithValueCtx
 func TestValidasiDuaVariable(t *testing.T) {
-	validate := validator.New()
+	validate, err := validation.New()
+	assert.Nil(t, err)
 
-	scenario := []struct {
-		Name            string
-		Password        string
-		ConfirmPassword string
-		ExpectError     bool
-	}{
+	validationtest.RunVar(t, validate, []validationtest.VarScenario[string]{
 		{
-			Name:            "test validation password failed",
-			Password:        "123456",
-			ConfirmPassword: "123",
-			ExpectError:     true,
+			Name:         "test validation password failed",
+			Input:        "123456",
+			Other:        "123",
+			Tag:          "eqfield",
+			ExpectErrors: []validationtest.Failure{{Tag: "eqfield"}},
 		},
 		{
-			Name:            "test validation password success",
-			Password:        "123456",
-			ConfirmPassword: "123456",
-			ExpectError:     false,
+			Name:  "test validation password success",
+			Input: "123456",
+			Other: "123456",
+			Tag:   "eqfield",
 		},
-	}
-
-	for _, scTest := range scenario {
-		t.Run(scTest.Name, func(t *testing.T) {
-			err := validate.VarWithValueCtx(context.Background(), scTest.Password, scTest.ConfirmPassword, "eqfield")
-			if err != nil {
-				if validationErrors, ok := err.(validator.ValidationErrors); ok {
-					for _, errorField := range validationErrors {
-						log.Printf("error on field [%v] with tag [%v]", errorField.Field(), errorField.Tag())
-					}
-				}
-			}
-
-			assert.Equal(t, err != nil, scTest.ExpectError)
-		})
-	}
+	})
 }
 
 // TestBackedInValidation untuk validasi menggunakan tag bawaan
 // test menggunakan tag yang sudah disediakan oleh package validator
 // kita hanya perlu menggunakan nama tag nya saja di validate
 func TestBackedInValidation(t *testing.T) {
-	validate := validator.New()
+	validate, err := validation.New()
+	assert.Nil(t, err)
 
-	scenario := []struct {
-		Name        string `json:"name,omitempty"`
-		Input       string `json:"input,omitempty"`
-		Tag         string `json:"tag,omitempty"`
-		ExpectError bool   `json:"expect_error,omitempty"`
-	}{
+	validationtest.RunVar(t, validate, []validationtest.VarScenario[string]{
 		{
-			Name:        "test validation required failed",
-			Input:       "",
-			Tag:         "required",
-			ExpectError: true,
+			Name:         "test validation required failed",
+			Input:        "",
+			Tag:          "required",
+			ExpectErrors: []validationtest.Failure{{Tag: "required"}},
 		},
 		{
-			Name:        "test validation required success",
-			Input:       "hello world",
-			Tag:         "required",
-			ExpectError: false,
+			Name:  "test validation required success",
+			Input: "hello world",
+			Tag:   "required",
 		},
 		{
-			Name:        "test validation patter ip address failed",
-			Input:       "172.www",
-			Tag:         "ip",
-			ExpectError: true,
+			Name:         "test validation patter ip address failed",
+			Input:        "172.www",
+			Tag:          "ip",
+			ExpectErrors: []validationtest.Failure{{Tag: "ip"}},
 		},
 		{
-			Name:        "test validation pattern ip address success",
-			Input:       "172.18.41.238",
-			Tag:         "ip",
-			ExpectError: false,
+			Name:  "test validation pattern ip address success",
+			Input: "172.18.41.238",
+			Tag:   "ip",
 		},
-	}
-
-	for _, scenarioTest := range scenario {
-		t.Run(scenarioTest.Name, func(t *testing.T) {
-			err := validate.VarCtx(context.Background(), scenarioTest.Input, scenarioTest.Tag)
-			if err != nil {
-				if validationErrors, ok := err.(validator.ValidationErrors); ok {
-					for _, errorField := range validationErrors {
-						log.Printf("error with tag [%v]", errorField.Tag())
-					}
-				}
-			}
-
-			assert.Equal(t, err != nil, scenarioTest.ExpectError)
-		})
-	}
+	})
 }
 
 // TestMultipleTagValidation untuk validasi menggunakan lebih dari satu tag
 // contoh : validate.VarCtx(ctx, input, "required,min=2,max=10")
+// validasi berhenti di tag pertama yang gagal
 func TestMultipleTagValidation(t *testing.T) {
-	validate := validator.New()
+	validate, err := validation.New()
+	assert.Nil(t, err)
 
-	scenario := []struct {
-		Name        string
-		Input       string
-		Tag         string
-		ExpectError bool
-	}{
+	validationtest.RunVar(t, validate, []validationtest.VarScenario[string]{
 		{
-			Name:        "test required and min failed",
-			Input:       "reo",
-			Tag:         "required,min=3,ip",
-			ExpectError: true,
+			Name:         "test required and min failed",
+			Input:        "reo",
+			Tag:          "required,min=3,ip",
+			ExpectErrors: []validationtest.Failure{{Tag: "ip"}},
 		},
 		{
-			Name:        "test required and min success",
-			Input:       "172.18.231.248",
-			Tag:         "required,min=3,ip",
-			ExpectError: false,
+			Name:  "test required and min success",
+			Input: "172.18.231.248",
+			Tag:   "required,min=3,ip",
 		},
-	}
-
-	for _, scenarioTest := range scenario {
-		t.Run(scenarioTest.Name, func(t *testing.T) {
-			err := validate.VarCtx(context.Background(), scenarioTest.Input, scenarioTest.Tag)
-			if err != nil {
-				if validationErrors, ok := err.(validator.ValidationErrors); ok {
-					for _, errorField := range validationErrors {
-						log.Printf("error with tag [%v] with error [%v]", errorField.Tag(), errorField.Error())
-					}
-				}
-			}
-
-			assert.Equal(t, err != nil, scenarioTest.ExpectError)
-		})
-	}
+	})
 }
 
 // TestTagParameter untuk validasi yang perlu menggunakan parameter dalam tag
 // misal ingin validasi teks harus minimal 5 karakter, maka menggunakan min=5
 // contoh "required,min=5"
 func TestTagParameter(t *testing.T) {
-	validate := validator.New()
+	validate, err := validation.New()
+	assert.Nil(t, err)
 
-	scenario := []struct {
-		Name        string
-		Input       string
-		Tag         string
-		ExpectError bool
-	}{
+	validationtest.RunVar(t, validate, []validationtest.VarScenario[string]{
 		{
-			Name:        "test tag parameter min and max failed",
-			Input:       "re",
-			Tag:         "required,min=3,max=10",
-			ExpectError: true,
+			Name:         "test tag parameter min and max failed",
+			Input:        "re",
+			Tag:          "required,min=3,max=10",
+			ExpectErrors: []validationtest.Failure{{Tag: "min"}},
 		},
 		{
-			Name:        "test tag parameter min and max success",
-			Input:       "reo s",
-			Tag:         "required,min=3,max=10",
-			ExpectError: false,
+			Name:  "test tag parameter min and max success",
+			Input: "reo s",
+			Tag:   "required,min=3,max=10",
 		},
-	}
-
-	for _, scenarioTest := range scenario {
-		t.Run(scenarioTest.Name, func(t *testing.T) {
-			err := validate.VarCtx(context.Background(), scenarioTest.Input, scenarioTest.Tag)
-			if err != nil {
-				if validationErrors, ok := err.(validator.ValidationErrors); ok {
-					for _, errorField := range validationErrors {
-						log.Printf("error with tag [%v]", errorField.Tag())
-					}
-				}
-			}
-
-			assert.Equal(t, err != nil, scenarioTest.ExpectError)
-		})
-	}
+	})
 }
 
 // TestValidasiStruct untuk melakukan validasi pada variabel yang tipe datanya struct
 // tuliskan tag pada masing-masing field yang ada di dalam struct
 // tambahkan tag `validate:"required"` pada fieldnya
 func TestValidasiStruct(t *testing.T) {
-	validate := validator.New()
+	validate, err := validation.New()
+	assert.Nil(t, err)
 
 	type LoginRequest struct {
 		Username string `json:"username,omitempty" validate:"required,email"`
 		Password string `json:"password,omitempty" validate:"required,min=6"`
 	}
 
-	scenario := []struct {
-		Name          string
-		Input         LoginRequest
-		ExpectedError bool
-	}{
+	validationtest.RunStruct(t, validate, []validationtest.StructScenario[LoginRequest]{
 		{
 			Name: "test validasi struct failed",
 			Input: LoginRequest{
 				Username: "reo",
 				Password: "123",
 			},
-			ExpectedError: true,
+			ExpectErrors: []validationtest.Failure{
+				{Field: "Username", Tag: "email"},
+				{Field: "Password", Tag: "min"},
+			},
 		},
 		{
 			Name: "test validasi struct success",
@@ -290,40 +181,32 @@ func TestValidasiStruct(t *testing.T) {
 				Username: "reo123@gmail.com",
 				Password: "123456",
 			},
-			ExpectedError: false,
 		},
-	}
-
-	for _, scenarioTest := range scenario {
-		t.Run(scenarioTest.Name, func(t *testing.T) {
-			err := validate.StructCtx(context.Background(), scenarioTest.Input)
-
-			assert.Equal(t, err != nil, scenarioTest.ExpectedError)
-		})
-	}
+	})
 }
 
 // TestValidationErrors untuk mengecek error yang dikembalikan
+// setiap field hanya mengembalikan tag pertama yang gagal
 func TestValidationErrors(t *testing.T) {
-	validate := validator.New()
+	validate, err := validation.New()
+	assert.Nil(t, err)
 
 	type LoginRequet struct {
 		Username string `json:"username,omitempty" validate:"required,email,min=3"`
 		Password string `json:"password,omitempty" validate:"required,alpha,min=6"`
 	}
 
-	scenario := []struct {
-		Name        string       `json:"name,omitempty"`
-		Input       *LoginRequet `json:"input,omitempty"`
-		ExpectError bool         `json:"expect_error,omitempty"`
-	}{
+	validationtest.RunStruct(t, validate, []validationtest.StructScenario[*LoginRequet]{
 		{
 			Name: "test struct validation errors failed",
 			Input: &LoginRequet{
 				Username: "r",
 				Password: "123",
 			},
-			ExpectError: true,
+			ExpectErrors: []validationtest.Failure{
+				{Field: "Username", Tag: "email"},
+				{Field: "Password", Tag: "alpha"},
+			},
 		},
 		{
 			Name: "test struct validation errors success",
@@ -331,30 +214,15 @@ func TestValidationErrors(t *testing.T) {
 				Username: "emailtest@gmail.com",
 				Password: "qwerty",
 			},
-			ExpectError: false,
 		},
-	}
-
-	for _, scenarioTest := range scenario {
-		t.Run(scenarioTest.Name, func(t *testing.T) {
-			err := validate.StructCtx(context.Background(), *scenarioTest.Input)
-			if err != nil {
-				if validationErrors, ok := err.(validator.ValidationErrors); ok {
-					for _, errorField := range validationErrors {
-						log.Printf("error on field [%v] with tag [%v]\n", errorField.Field(), errorField.Tag())
-					}
-				}
-			}
-
-			assert.Equal(t, err != nil, scenarioTest.ExpectError)
-		})
-	}
+	})
 }
 
 // TestValidasiNestedStruct untuk melakukan validasi pasa struct dengan field tipe data struct
 // misal Address *Address `validate:"required"`
 func TestValidasiNestedStruct(t *testing.T) {
-	validate := validator.New()
+	validate, err := validation.New()
+	assert.Nil(t, err)
 
 	type Address struct {
 		City    string `json:"city,omitempty" validate:"required"`
@@ -366,18 +234,17 @@ func TestValidasiNestedStruct(t *testing.T) {
 		Address *Address `json:"address,omitempty" validate:"required"`
 	}
 
-	scenario := []struct {
-		Name        string
-		Input       *User
-		ExpectError bool
-	}{
+	validationtest.RunStruct(t, validate, []validationtest.StructScenario[*User]{
 		{
 			Name: "test validate nested failed",
 			Input: &User{
 				Name:    "reo",
 				Address: &Address{},
 			},
-			ExpectError: true,
+			ExpectErrors: []validationtest.Failure{
+				{Field: "Address.City", Tag: "required"},
+				{Field: "Address.Country", Tag: "required"},
+			},
 		},
 		{
 			Name: "test validate nested success",
@@ -388,31 +255,16 @@ func TestValidasiNestedStruct(t *testing.T) {
 					Country: "Indonesia",
 				},
 			},
-			ExpectError: false,
 		},
-	}
-
-	for _, scenarioTest := range scenario {
-		t.Run(scenarioTest.Name, func(t *testing.T) {
-			err := validate.StructCtx(context.Background(), scenarioTest.Input)
-			if err != nil {
-				if validationErrrors, ok := err.(validator.ValidationErrors); ok {
-					for _, errorField := range validationErrrors {
-						log.Printf("%v\n", errorField.Error())
-					}
-				}
-			}
-
-			assert.Equal(t, err != nil, scenarioTest.ExpectError)
-		})
-	}
+	})
 }
 
 // TestValidasiSlice untuk melakukan validasi tipe data slice/array
 // menggunakan tag 'dive'
 // contoh Address []Address `validate:"required,dive"`
 func TestValidasiSlice(t *testing.T) {
-	validate := validator.New()
+	validate, err := validation.New()
+	assert.Nil(t, err)
 
 	type Address struct {
 		City    string `json:"city,omitempty" validate:"required,min=2"`
@@ -424,11 +276,7 @@ func TestValidasiSlice(t *testing.T) {
 		Addresses []Address `json:"addresses,omitempty" validate:"required,dive"`
 	}
 
-	scenario := []struct {
-		Name        string
-		Input       *User
-		ExpectError bool
-	}{
+	validationtest.RunStruct(t, validate, []validationtest.StructScenario[*User]{
 		{
 			Name: "test validate slice failed",
 			Input: &User{
@@ -444,7 +292,12 @@ func TestValidasiSlice(t *testing.T) {
 					},
 				},
 			},
-			ExpectError: true,
+			ExpectErrors: []validationtest.Failure{
+				{Field: "Addresses[0].City", Tag: "required"},
+				{Field: "Addresses[0].Country", Tag: "required"},
+				{Field: "Addresses[1].City", Tag: "required"},
+				{Field: "Addresses[1].Country", Tag: "required"},
+			},
 		},
 		{
 			Name: "test validate slice struct success",
@@ -457,47 +310,33 @@ func TestValidasiSlice(t *testing.T) {
 					},
 				},
 			},
-			ExpectError: false,
 		},
-	}
-
-	for _, testScenario := range scenario {
-		t.Run(testScenario.Name, func(t *testing.T) {
-			err := validate.StructCtx(context.Background(), testScenario.Input)
-			if err != nil {
-				for _, errorField := range err.(validator.ValidationErrors) {
-					log.Println(errorField.Error())
-				}
-			}
-
-			assert.Equal(t, err != nil, testScenario.ExpectError)
-		})
-	}
+	})
 }
 
 // TestValidasiBasicSlice untuk validasi slice tipe data basic
 // contohnya validasi []string `validate:"required,dive,ip"`
 // tag validasi valuenya ditulis setelah dive
 func TestValidasiBasicSlice(t *testing.T) {
-	validate := validator.New()
+	validate, err := validation.New()
+	assert.Nil(t, err)
 
 	type Server struct {
 		Name        string   `json:"name,omitempty" validate:"required"`
 		IPAddresses []string `json:"ip_addresses,omitempty" validate:"required,dive,ip"`
 	}
 
-	scenario := []struct {
-		Name        string
-		Input       *Server
-		ExpectError bool
-	}{
+	validationtest.RunStruct(t, validate, []validationtest.StructScenario[*Server]{
 		{
 			Name: "test validasi basic slice failed",
 			Input: &Server{
 				Name:        "server dev",
 				IPAddresses: []string{"qwert", "wasd"},
 			},
-			ExpectError: true,
+			ExpectErrors: []validationtest.Failure{
+				{Field: "IPAddresses[0]", Tag: "ip"},
+				{Field: "IPAddresses[1]", Tag: "ip"},
+			},
 		},
 		{
 			Name: "test validasi basic slice success",
@@ -505,42 +344,23 @@ func TestValidasiBasicSlice(t *testing.T) {
 				Name:        "server production",
 				IPAddresses: []string{"172.100.100.101", "172.100.100.102"},
 			},
-			ExpectError: false,
 		},
-	}
-
-	for _, testScenario := range scenario {
-		t.Run(testScenario.Name, func(t *testing.T) {
-			err := validate.StructCtx(context.Background(), testScenario.Input)
-			if err != nil {
-				for _, errorField := range err.(validator.ValidationErrors) {
-					log.Println(errorField.Error())
-				}
-			}
-
-			assert.Equal(t, err != nil, testScenario.ExpectError)
-		})
-	}
-
+	})
 }
 
 // TestValidasiMap untuk validasi pada tipe data map
 // dalam map karea ada key-value. maka kita bisa menambahkan dive untuk key dan value
 // menggunakan keys dan endkeys
 func TestValidasiMap(t *testing.T) {
-	validate := validator.New()
+	validate, err := validation.New()
+	assert.Nil(t, err)
 
 	type School struct {
 		Name    string `json:"name,omitempty" validate:"required,min=2"`
 		Address string `json:"address,omitempty" validate:"required,min=2"`
 	}
 
-	scenario := []struct {
-		Name        string
-		Input       map[string]*School // variabel yang akan divalidasi
-		Tag         string             // tag validation untuk map
-		ExpectError bool
-	}{
+	validationtest.RunVar(t, validate, []validationtest.VarScenario[map[string]*School]{
 		{
 			Name: "test validation map failed",
 			Input: map[string]*School{
@@ -549,8 +369,12 @@ func TestValidasiMap(t *testing.T) {
 					Address: "a",
 				},
 			},
-			Tag:         "dive,keys,min=2,endkeys,required",
-			ExpectError: true,
+			Tag: "dive,keys,min=2,endkeys,required",
+			ExpectErrors: []validationtest.Failure{
+				{Field: "[s]", Tag: "min"},
+				{Field: "[s].Name", Tag: "min"},
+				{Field: "[s].Address", Tag: "min"},
+			},
 		},
 		{
 			Name: "test validatio map success",
@@ -560,37 +384,19 @@ func TestValidasiMap(t *testing.T) {
 					Address: "Jakarta Selatan",
 				},
 			},
-			Tag:         "required,dive,keys,min=2,endkeys,required",
-			ExpectError: false,
+			Tag: "required,dive,keys,min=2,endkeys,required",
 		},
-	}
-
-	for _, testScenario := range scenario {
-		t.Run(testScenario.Name, func(t *testing.T) {
-			err := validate.VarCtx(context.Background(), testScenario.Input, testScenario.Tag)
-			if err != nil {
-				for _, errorField := range err.(validator.ValidationErrors) {
-					log.Println(errorField.Error())
-				}
-			}
-
-			assert.Equal(t, err != nil, testScenario.ExpectError)
-		})
-	}
+	})
 }
 
 // TestValidasiBasicMap untuk validasi pada tipe data basic map[string]string
 // karena map punya key-value, untuk vlaidasi tag pada key wajib ditambahkan keys dan endkeys
 // contoh : map[string]string `required,dive,keys,required,alpha,endkeys,dive,email,min=5`
 func TestValidasiBasicMap(t *testing.T) {
-	validate := validator.New()
+	validate, err := validation.New()
+	assert.Nil(t, err)
 
-	scenario := []struct {
-		Name        string
-		Input       map[string]string // variabel yang akan divalidasi
-		Tag         string            // tag validasi untuk variabel map
-		ExpectError bool
-	}{
+	validationtest.RunVar(t, validate, []validationtest.VarScenario[map[string]string]{
 		{
 			Name: "test validasi basic map failed",
 			Input: map[string]string{
@@ -599,8 +405,12 @@ func TestValidasiBasicMap(t *testing.T) {
 				"user3": "",
 				"a":     "reo@gmail.com",
 			},
-			Tag:         "required,dive,keys,required,min=3,endkeys,required,email,min=12",
-			ExpectError: true,
+			Tag: "required,dive,keys,required,min=3,endkeys,required,email,min=12",
+			ExpectErrors: []validationtest.Failure{
+				{Field: "[user1]", Tag: "email"},
+				{Field: "[user3]", Tag: "required"},
+				{Field: "[a]", Tag: "min"},
+			},
 		},
 		{
 			Name: "test validasi basic map success",
@@ -609,222 +419,115 @@ func TestValidasiBasicMap(t *testing.T) {
 				"server2": "172.18.10.23",
 				"server3": "172.18.10.24",
 			},
-			Tag:         "required,dive,keys,required,endkeys,required,ip",
-			ExpectError: false,
+			Tag: "required,dive,keys,required,endkeys,required,ip",
 		},
-	}
-
-	for _, testScenario := range scenario {
-		t.Run(testScenario.Name, func(t *testing.T) {
-			err := validate.VarCtx(context.Background(), testScenario.Input, testScenario.Tag)
-			if err != nil {
-				for _, errorField := range err.(validator.ValidationErrors) {
-					log.Println(errorField.Error())
-				}
-			}
-
-			assert.Equal(t, err != nil, testScenario.ExpectError)
-		})
-	}
+	})
 }
 
 // TestAliasTag untuk mengganti nama tag sesuai dengan custom tag kita
 // valdate.RegisterAlias(alias, tag)
+// alias app_email sudah didaftarkan oleh validation.New()
 func TestAliasTag(t *testing.T) {
-	validate := validator.New()
-	validate.RegisterAlias("app_email", "required,email,min=15")
+	validate, err := validation.New()
+	assert.Nil(t, err)
 
-	scenario := []struct {
-		Name        string
-		Input       string
-		ExpectError bool
-	}{
+	validationtest.RunVar(t, validate, []validationtest.VarScenario[string]{
 		{
-			Name:        "test validasi using alias failed",
-			Input:       "reoo",
-			ExpectError: true,
+			Name:         "test validasi using alias failed",
+			Input:        "reoo",
+			Tag:          "app_email",
+			ExpectErrors: []validationtest.Failure{{Tag: "app_email"}},
 		},
 		{
-			Name:        "test validasi using alias success",
-			Input:       "reoshby1299@gmail.com",
-			ExpectError: false,
+			Name:  "test validasi using alias success",
+			Input: "reoshby1299@gmail.com",
+			Tag:   "app_email",
 		},
-	}
-
-	for _, testScenario := range scenario {
-		t.Run(testScenario.Name, func(t *testing.T) {
-			err := validate.VarCtx(context.Background(), testScenario.Input, "app_email")
-			if err != nil {
-				for _, errorField := range err.(validator.ValidationErrors) {
-					log.Println(errorField.Error())
-				}
-			}
-
-			assert.Equal(t, err != nil, testScenario.ExpectError)
-		})
-	}
+	})
 }
 
 // TestCustomValidation untuk menambahkan costum logic validasi
 // misal kita memiliki validasi yang lebih kompleks, bisa buat sendiri logic validasinya
 // kemudian kita register aliasnya dengan tag yang kita inginkan
+// tag category sudah didaftarkan oleh validation.New() menggunakan validation.ValidateCategory
 func TestCustomValidation(t *testing.T) {
-	validate := validator.New()
-
-	// create constum function validation
-	validateType := func(field validator.FieldLevel) bool {
-		ourType := []string{"hobby", "gadget", "adventure", "automotive"}
-
-		value, ok := field.Field().Interface().(string)
-		if !ok {
-			return false
-		}
-		return slices.Contains(ourType, value)
-	}
-
-	// register
-	validate.RegisterValidation("category", validateType, false)
+	validate, err := validation.New()
+	assert.Nil(t, err)
 
-	scenario := []struct {
-		Name        string
-		Input       string
-		ExpectError bool
-	}{
+	validationtest.RunVar(t, validate, []validationtest.VarScenario[string]{
 		{
-			Name:        "test validation category failed",
-			Input:       "abcd",
-			ExpectError: true,
+			Name:         "test validation category failed",
+			Input:        "abcd",
+			Tag:          "category",
+			ExpectErrors: []validationtest.Failure{{Tag: "category"}},
 		},
 		{
-			Name:        "test validation category success",
-			Input:       "gadget",
-			ExpectError: false,
+			Name:  "test validation category success",
+			Input: "gadget",
+			Tag:   "category",
 		},
-	}
-
-	for _, testScenario := range scenario {
-		t.Run(testScenario.Name, func(t *testing.T) {
-			err := validate.VarCtx(context.Background(), testScenario.Input, "category")
-			if err != nil {
-				for _, errorField := range err.(validator.ValidationErrors) {
-					log.Println(errorField.Error())
-				}
-			}
-
-			assert.Equal(t, err != nil, testScenario.ExpectError)
-		})
-	}
+	})
 }
 
 // TestCustomValidationParameter untuk menambahkan costum validasi yang memerlukan parameter
+// tag min_category sudah didaftarkan oleh validation.New() menggunakan validation.ValidateMinCategory
+// parameter yang bukan angka membuat validasi gagal, tidak panic lagi
+// validation.VarCtx mengembalikan *validation.ParamError untuk parameter tersebut
 func TestCustomValidationParameter(t *testing.T) {
-	validate := validator.New()
-
-	// create function length array
-	validateMinCategory := func(field validator.FieldLevel) bool {
-		length, err := strconv.Atoi(field.Param())
-		if err != nil {
-			panic(err)
-			return false
-		}
-
-		ourType := []string{"a", "b", "c", "d", "e"}
-		value, ok := field.Field().Interface().([]string)
-		if !ok {
-			return false
-		}
-
-		// cek eaech type
-		for _, catType := range value {
-			if !slices.Contains(ourType, catType) {
-				return false
-			}
-		}
-
-		if len(value) < length {
-			return false
-		}
-
-		return true
-	}
-
-	// register validate
-	validate.RegisterValidation("min_category", validateMinCategory)
+	validate, err := validation.New()
+	assert.Nil(t, err)
 
-	// create scenario
-	scenario := []struct {
-		Name        string
-		Input       []string
-		ExpectError bool
-	}{
+	validationtest.RunVar(t, validate, []validationtest.VarScenario[[]string]{
 		{
-			Name:        "test validasi category param failed",
-			Input:       []string{"a"},
-			ExpectError: true,
+			Name:         "test validasi category param failed",
+			Input:        []string{"a"},
+			Tag:          "min_category=2",
+			ExpectErrors: []validationtest.Failure{{Tag: "min_category"}},
 		},
 		{
-			Name:        "test validasi category param success",
-			Input:       []string{"a", "b", "c"},
-			ExpectError: false,
+			Name:  "test validasi category param success",
+			Input: []string{"a", "b", "c"},
+			Tag:   "min_category=2",
 		},
 		{
-			Name:        "test validasi category not in options",
-			Input:       []string{"r", "e", "o"},
-			ExpectError: true,
+			Name:         "test validasi category not in options",
+			Input:        []string{"r", "e", "o"},
+			Tag:          "min_category=2",
+			ExpectErrors: []validationtest.Failure{{Tag: "min_category"}},
 		},
-	}
-
-	for _, testScenario := range scenario {
-		t.Run(testScenario.Name, func(t *testing.T) {
-			err := validate.VarCtx(context.Background(), testScenario.Input, "min_category=2")
-			if err != nil {
-				for _, errorField := range err.(validator.ValidationErrors) {
-					log.Println(errorField.Error())
-				}
-			}
+		{
+			Name:         "test validasi category invalid param tidak panic",
+			Input:        []string{"a", "b", "c"},
+			Tag:          "min_category=x",
+			ExpectErrors: []validationtest.Failure{{Tag: "min_category"}},
+		},
+	})
 
-			assert.Equal(t, err != nil, testScenario.ExpectError)
-		})
-	}
+	var paramError *validation.ParamError
+	assert.ErrorAs(t, validation.VarCtx(context.Background(), validate, []string{"a", "b", "c"}, "min_category=x"), &paramError)
 }
 
 // TestCustomMessageValidation untuk memberi message validasi sesuai dengan custom kita
+// tag gender sudah didaftarkan oleh validation.New() menggunakan validation.ValidateGender
 func TestCustomMessageValidation(t *testing.T) {
-	validate := validator.New()
-
-	// register custom gender validation
-	genderValidation := func(field validator.FieldLevel) bool {
-		value, ok := field.Field().Interface().(string)
-		if !ok {
-			return false
-		}
+	validate, err := validation.New()
+	assert.Nil(t, err)
 
-		return slices.Contains([]string{"male", "female"}, value)
-	}
-
-	// add register
-	validate.RegisterValidation("gender", genderValidation)
-
-	// add custom Message
-	ErrorMessage := func(tag string) string {
-		switch tag {
-		case "gender":
-			return "gender must be male or female"
-		default:
-			return "unknown error"
-		}
-	}
+	// message diambil dari catalog, bukan dari switch tag lagi
+	catalog := validation.NewCatalog()
 
 	// create scenario
 	scenario := []struct {
-		Name        string
-		Input       string
-		ExpectError bool
+		Name           string
+		Input          string
+		ExpectError    bool
+		ExpectMessages []string
 	}{
 		{
-			Name:        "test validation gender failed",
-			Input:       "mafale",
-			ExpectError: true,
+			Name:           "test validation gender failed",
+			Input:          "mafale",
+			ExpectError:    true,
+			ExpectMessages: []string{"value must be one of [male female], got 'mafale'"},
 		},
 		{
 			Name:        "test validation gender success",
@@ -836,13 +539,13 @@ func TestCustomMessageValidation(t *testing.T) {
 	for _, testScenario := range scenario {
 		t.Run(testScenario.Name, func(t *testing.T) {
 			err := validate.VarCtx(context.Background(), testScenario.Input, "gender")
-			if err != nil {
-				for _, errorField := range err.(validator.ValidationErrors) {
-					log.Println(ErrorMessage(errorField.Tag()))
-				}
+			messages := catalog.Messages(err)
+			for _, message := range messages {
+				log.Println(message)
 			}
 
 			assert.Equal(t, err != nil, testScenario.ExpectError)
+			assert.Equal(t, testScenario.ExpectMessages, messages)
 		})
 	}
 
@@ -0,0 +1,40 @@
+package test
+
+import (
+	"context"
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"go-validation/validation"
+	"go-validation/validationtest"
+	"testing"
+)
+
+// TestFailures untuk memastikan field dan tag yang gagal dibaca dari validator.ValidationErrors
+// field memakai nama dari tag json jika validator dibuat dengan validation.WithFieldNameTag
+func TestFailures(t *testing.T) {
+	validate, err := validation.New(validation.WithFieldNameTag("json"))
+	assert.Nil(t, err)
+
+	type Address struct {
+		City string `json:"city,omitempty" validate:"required"`
+	}
+
+	type User struct {
+		Name      string    `json:"name,omitempty" validate:"required"`
+		Addresses []Address `json:"addresses,omitempty" validate:"required,dive"`
+	}
+
+	err = validate.StructCtx(context.Background(), User{Addresses: []Address{{City: "Jakarta"}, {}}})
+	assert.NotNil(t, err)
+
+	assert.Equal(t, []validationtest.Failure{
+		{Field: "name", Tag: "required"},
+		{Field: "addresses[1].city", Tag: "required"},
+	}, validationtest.Failures(err.(validator.ValidationErrors)))
+
+	assert.True(t, validationtest.AssertFailures(t, err, []validationtest.Failure{
+		{Field: "addresses[1].city", Tag: "required"},
+		{Field: "name", Tag: "required"},
+	}))
+	assert.True(t, validationtest.AssertFailures(t, nil, nil))
+}
@@ -0,0 +1,147 @@
+package test
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"go-validation/validation"
+	"go-validation/validationtest"
+	"testing"
+)
+
+// TestValueSet untuk validasi value harus ada di set dengan nama tertentu menggunakan tag inset
+// set category dan gender sudah ada, set lain bisa didaftarkan dari Go atau dibaca dari file config
+func TestValueSet(t *testing.T) {
+	assert.Nil(t, validation.RegisterSet(validation.ValueSet{Name: "level", Values: []string{"junior", "senior"}}))
+	assert.Nil(t, validation.LoadSets("testdata/config/sets.yaml"))
+
+	validate, err := validation.New()
+	assert.Nil(t, err)
+
+	validationtest.RunVar(t, validate, []validationtest.VarScenario[string]{
+		{Name: "test inset category success", Input: "gadget", Tag: "inset=category"},
+		{Name: "test inset category failed", Input: "sleep", Tag: "inset=category", ExpectErrors: []validationtest.Failure{{Tag: "inset"}}},
+		{Name: "test inset gender success", Input: "female", Tag: "inset=gender"},
+		{Name: "test inset gender case sensitive", Input: "Female", Tag: "inset=gender", ExpectErrors: []validationtest.Failure{{Tag: "inset"}}},
+		{Name: "test inset from go success", Input: "senior", Tag: "inset=level"},
+		{Name: "test inset from file success", Input: "active", Tag: "inset=status"},
+		{Name: "test inset ignore case success", Input: "idr", Tag: "inset=currency"},
+		{Name: "test inset ignore case failed", Input: "eur", Tag: "inset=currency", ExpectErrors: []validationtest.Failure{{Tag: "inset"}}},
+		{Name: "test inset unknown set", Input: "a", Tag: "inset=unknown", ExpectErrors: []validationtest.Failure{{Tag: "inset"}}},
+	})
+}
+
+// TestValueSetMessage untuk message dari tag inset berisi daftar value yang valid
+func TestValueSetMessage(t *testing.T) {
+	validate, err := validation.New()
+	assert.Nil(t, err)
+
+	catalog := validation.NewCatalog()
+
+	scenario := []struct {
+		Name           string
+		Input          string
+		Tag            string
+		Locale         string
+		ExpectMessages []string
+	}{
+		{
+			Name:           "test message inset gender",
+			Input:          "mafale",
+			Tag:            "inset=gender",
+			Locale:         validation.LocaleEnglish,
+			ExpectMessages: []string{"value must be one of [male female], got 'mafale'"},
+		},
+		{
+			Name:           "test message inset category indonesian",
+			Input:          "sleep",
+			Tag:            "inset=category",
+			Locale:         validation.LocaleIndonesian,
+			ExpectMessages: []string{"nilai harus salah satu dari [hobby gadget adventure automotive], bukan 'sleep'"},
+		},
+		{
+			Name:           "test message category tag",
+			Input:          "sleep",
+			Tag:            "category",
+			Locale:         validation.LocaleEnglish,
+			ExpectMessages: []string{"value must be one of [hobby gadget adventure automotive], got 'sleep'"},
+		},
+	}
+
+	for _, testScenario := range scenario {
+		t.Run(testScenario.Name, func(t *testing.T) {
+			err := validate.VarCtx(context.Background(), testScenario.Input, testScenario.Tag)
+			assert.Equal(t, testScenario.ExpectMessages, catalog.TranslateAll(err, testScenario.Locale))
+		})
+	}
+}
+
+// TestValueSetGenderMessage untuk message tag gender mengikuti set gender yang sedang terdaftar
+func TestValueSetGenderMessage(t *testing.T) {
+	gender, ok := validation.LookupSet(validation.SetGender)
+	assert.True(t, ok)
+	t.Cleanup(func() {
+		assert.Nil(t, validation.RegisterSet(gender))
+	})
+
+	assert.Nil(t, validation.RegisterSet(validation.ValueSet{Name: validation.SetGender, Values: []string{"male", "female", "other"}}))
+
+	validate, err := validation.New()
+	assert.Nil(t, err)
+
+	err = validate.VarCtx(context.Background(), "mafale", validation.TagGender)
+	assert.Equal(t, []string{"value must be one of [male female other], got 'mafale'"}, validation.NewCatalog().Messages(err))
+}
+
+// TestValueSetInvalid untuk set tanpa nama atau tanpa value ditolak
+func TestValueSetInvalid(t *testing.T) {
+	assert.NotNil(t, validation.RegisterSet(validation.ValueSet{Values: []string{"a"}}))
+	assert.NotNil(t, validation.RegisterSet(validation.ValueSet{Name: "empty"}))
+
+	_, ok := validation.LookupSet("empty")
+	assert.False(t, ok)
+}
+
+// TestValueSetTag untuk custom tag dari WithSetTag memakai set nya di message dan saran
+// tag lain tidak memakai set walaupun nama set sama dengan nama tag
+func TestValueSetTag(t *testing.T) {
+	assert.Nil(t, validation.RegisterSet(validation.ValueSet{Name: "plan", Values: []string{"basic", "premium"}}))
+	assert.Nil(t, validation.RegisterSet(validation.ValueSet{Name: "lowercase", Values: []string{"reo"}}))
+
+	validate, err := validation.New(validation.WithSetTag("plan", "plan"))
+	assert.Nil(t, err)
+
+	catalog := validation.NewCatalog()
+	catalog.SetSuggester(validation.NewSuggester())
+
+	scenario := []struct {
+		Name             string
+		Input            string
+		Tag              string
+		ExpectSuggestion string
+	}{
+		{
+			Name:             "test set tag suggestion",
+			Input:            "premim",
+			Tag:              "plan",
+			ExpectSuggestion: "premium",
+		},
+		{
+			Name:  "test tag bawaan tidak memakai set dengan nama yang sama",
+			Input: "Reo",
+			Tag:   "lowercase",
+		},
+	}
+
+	for _, testScenario := range scenario {
+		t.Run(testScenario.Name, func(t *testing.T) {
+			err := validate.VarCtx(context.Background(), testScenario.Input, testScenario.Tag)
+
+			fieldErrors := validation.FieldErrors(err, catalog, validation.LocaleEnglish)
+			if assert.Len(t, fieldErrors, 1) {
+				assert.Equal(t, testScenario.ExpectSuggestion, fieldErrors[0].Suggestion)
+			}
+		})
+	}
+
+	assert.Nil(t, validate.VarCtx(context.Background(), "basic", "plan"))
+}
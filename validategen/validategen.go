@@ -0,0 +1,931 @@
+// Package validategen untuk membuat method Validate(ctx) error tanpa reflection dari tag validate pada struct
+// error yang dihasilkan berupa validator.ValidationErrors dengan namespace, tag, param dan value
+// yang sama dengan validate.StructCtx, jadi Catalog, FieldErrors dan Problem tetap bisa dipakai
+//
+// tag yang didukung : required, omitempty, min, max, len, eq, ne, gt, gte, lt, lte, email, alpha,
+// alphanum, numeric, ip, ipv4, ipv6, oneof, eqfield, nefield, dive, keys/endkeys, or (|), alias
+// dan custom tag project (category, gender, inset, min_category)
+// tag lain membuat Generate mengembalikan error, bukan menghasilkan kode yang berbeda dengan validator
+package validategen
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"go-validation/validation"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// Config adalah konfigurasi untuk Generate
+type Config struct {
+	// Dir adalah folder package yang berisi struct
+	Dir string
+
+	// Types adalah nama struct yang dibuatkan method Validate
+	// struct lain di package yang sama yang dipakai oleh field ikut dibuatkan
+	Types []string
+
+	// Output adalah nama file hasil, isi file ini diabaikan saat membaca package
+	// supaya hasil generate lama yang sudah tidak cocok dengan struct tidak membuat Generate gagal
+	Output string
+
+	// Aliases adalah alias tag tambahan selain alias dari package validation
+	Aliases map[string]string
+
+	// TagName adalah nama tag yang dibaca, default validate
+	TagName string
+}
+
+// Generate untuk membuat source code berisi method Validate untuk setiap struct di config.Types
+func Generate(config Config) ([]byte, error) {
+	if len(config.Types) == 0 {
+		return nil, fmt.Errorf("no types to generate")
+	}
+
+	loaded, err := packages.Load(&packages.Config{
+		Mode:    packages.NeedName | packages.NeedImports | packages.NeedDeps | packages.NeedTypes | packages.NeedSyntax | packages.NeedTypesInfo,
+		Dir:     config.Dir,
+		Overlay: outputOverlay(config),
+	}, ".")
+	if err != nil {
+		return nil, err
+	}
+
+	if len(loaded) != 1 {
+		return nil, fmt.Errorf("expected one package in %s, found %d", config.Dir, len(loaded))
+	}
+
+	pkg := loaded[0]
+	if len(pkg.Errors) > 0 {
+		return nil, pkg.Errors[0]
+	}
+
+	generator := &generator{
+		pkg:     pkg.Types,
+		tagName: config.TagName,
+		aliases: map[string]string{validation.AliasAppEmail: validation.AppEmailTags},
+		imports: map[string]bool{},
+		queued:  map[*types.Named]bool{},
+	}
+	if generator.tagName == "" {
+		generator.tagName = "validate"
+	}
+	for alias, tags := range config.Aliases {
+		generator.aliases[alias] = tags
+	}
+
+	for _, name := range config.Types {
+		object := pkg.Types.Scope().Lookup(name)
+		if object == nil {
+			return nil, fmt.Errorf("type %s not found in package %s", name, pkg.Name)
+		}
+
+		named, ok := object.Type().(*types.Named)
+		if !ok {
+			return nil, fmt.Errorf("%s is not a named type", name)
+		}
+
+		if err := generator.enqueue(named); err != nil {
+			return nil, err
+		}
+	}
+
+	var body bytes.Buffer
+	for i := 0; i < len(generator.queue); i++ {
+		code, err := generator.generateType(generator.queue[i])
+		if err != nil {
+			return nil, err
+		}
+		body.WriteString(code)
+	}
+
+	var source bytes.Buffer
+	fmt.Fprintf(&source, "// Code generated by validategen. DO NOT EDIT.\n\npackage %s\n\nimport (\n", pkg.Name)
+	for _, path := range generator.importList() {
+		if path == "" {
+			source.WriteString("\n")
+			continue
+		}
+		fmt.Fprintf(&source, "%q\n", path)
+	}
+	source.WriteString(")\n\n")
+	source.Write(body.Bytes())
+
+	formatted, err := format.Source(source.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("format generated code: %w", err)
+	}
+
+	return formatted, nil
+}
+
+// outputOverlay untuk mengganti isi file hasil generate sebelumnya dengan package clause saja
+func outputOverlay(config Config) map[string][]byte {
+	if config.Output == "" {
+		return nil
+	}
+
+	path, err := filepath.Abs(filepath.Join(config.Dir, config.Output))
+	if err != nil {
+		return nil
+	}
+
+	file, err := parser.ParseFile(token.NewFileSet(), path, nil, parser.PackageClauseOnly)
+	if err != nil {
+		return nil
+	}
+
+	return map[string][]byte{path: []byte("package " + file.Name.Name + "\n")}
+}
+
+// DefaultOutput untuk nama file hasil dari nama file sumber, contoh : model.go -> model_validate.go
+func DefaultOutput(file string) string {
+	return strings.TrimSuffix(filepath.Base(file), ".go") + "_validate.go"
+}
+
+// generator menyimpan state selama membuat kode untuk satu package
+type generator struct {
+	pkg     *types.Package
+	tagName string
+	aliases map[string]string
+	imports map[string]bool
+
+	queue  []*types.Named
+	queued map[*types.Named]bool
+
+	// counter untuk nama variabel loop yang unik
+	vars int
+}
+
+// enqueue untuk menambahkan struct yang akan dibuatkan method
+func (g *generator) enqueue(named *types.Named) error {
+	if g.queued[named] {
+		return nil
+	}
+
+	if _, ok := named.Underlying().(*types.Struct); !ok {
+		return fmt.Errorf("%s is not a struct", named.Obj().Name())
+	}
+
+	if named.TypeParams().Len() > 0 {
+		return fmt.Errorf("generic type %s is not supported", named.Obj().Name())
+	}
+
+	g.queued[named] = true
+	g.queue = append(g.queue, named)
+	return nil
+}
+
+// importList untuk mengambil import yang dipakai, terurut dengan package std lebih dulu
+func (g *generator) importList() []string {
+	var std, external []string
+	for path := range g.imports {
+		if strings.Contains(path, ".") || strings.HasPrefix(path, "go-validation") {
+			external = append(external, path)
+		} else {
+			std = append(std, path)
+		}
+	}
+
+	slices.Sort(std)
+	slices.Sort(external)
+	if len(std) > 0 && len(external) > 0 {
+		// string kosong menjadi baris kosong di antara import std dan import lain
+		std = append(std, "")
+	}
+	return append(std, external...)
+}
+
+// use untuk mencatat import yang dipakai lalu mengembalikan nama package nya
+func (g *generator) use(path string) string {
+	g.imports[path] = true
+
+	// path dengan versi major seperti validator/v10 memakai nama sebelum versi
+	name := path[strings.LastIndex(path, "/")+1:]
+	if parent := strings.TrimSuffix(path, "/"+name); parent != path && len(name) > 1 && name[0] == 'v' {
+		if _, err := strconv.Atoi(name[1:]); err == nil {
+			name = parent[strings.LastIndex(parent, "/")+1:]
+		}
+	}
+	return name
+}
+
+// generateType untuk membuat method Validate dan validateNamespace dari satu struct
+func (g *generator) generateType(named *types.Named) (string, error) {
+	name := named.Obj().Name()
+	structType := named.Underlying().(*types.Struct)
+
+	var fields strings.Builder
+	for i := 0; i < structType.NumFields(); i++ {
+		field := structType.Field(i)
+		if !field.Exported() && !field.Embedded() {
+			continue
+		}
+
+		tag := reflect.StructTag(structType.Tag(i)).Get(g.tagName)
+		if tag == "-" {
+			continue
+		}
+
+		chain, err := g.parseTag(tag)
+		if err != nil {
+			return "", fmt.Errorf("%s.%s: %w", name, field.Name(), err)
+		}
+
+		current := site{
+			expr:       "s." + field.Name(),
+			name:       strconv.Quote(field.Name()),
+			parent:     "s",
+			parentType: structType,
+		}
+
+		code, err := g.value(current, field.Type(), chain)
+		if err != nil {
+			return "", fmt.Errorf("%s.%s: %w", name, field.Name(), err)
+		}
+		fields.WriteString(code)
+	}
+
+	context, validator := g.use("context"), g.use("github.com/go-playground/validator/v10")
+
+	var code strings.Builder
+	fmt.Fprintf(&code, "// Validate untuk validasi %s tanpa reflection, hasilnya sama dengan validate.StructCtx\n", name)
+	fmt.Fprintf(&code, "func (s *%s) Validate(ctx %s.Context) error {\n", name, context)
+	fmt.Fprintf(&code, "if s == nil {\nreturn &%s.InvalidValidationError{Type: %s.TypeOf(s)}\n}\n\n", validator, g.use("reflect"))
+	fmt.Fprintf(&code, "var errs %s.ValidationErrors\ns.validateNamespace(ctx, %q, &errs)\n", validator, name+".")
+	code.WriteString("if len(errs) > 0 {\nreturn errs\n}\nreturn nil\n}\n\n")
+	fmt.Fprintf(&code, "// validateNamespace untuk validasi field %s dengan namespace ns, dipakai juga oleh struct lain\n", name)
+	fmt.Fprintf(&code, "func (s *%s) validateNamespace(ctx %s.Context, ns string, errs *%s.ValidationErrors) {\n", name, context, validator)
+	code.WriteString(fields.String())
+	code.WriteString("}\n\n")
+
+	return code.String(), nil
+}
+
+// site adalah value yang sedang divalidasi
+type site struct {
+	// expr adalah expression Go dari value
+	expr string
+
+	// name adalah expression Go (string) dari nama field, misal "Addresses[" + strconv.Itoa(i1) + "]"
+	name string
+
+	// fromPointer true jika value berasal dari pointer yang tidak nil, required dan omitempty selalu lolos
+	fromPointer bool
+
+	// parent adalah expression dari struct pemilik field, dipakai oleh eqfield dan nefield
+	parent     string
+	parentType *types.Struct
+}
+
+// node adalah satu bagian tag yang sudah di-parse
+type node struct {
+	kind  string
+	tag   string
+	param string
+	alias string
+
+	// alternatives berisi tag di dalam or (|)
+	alternatives []node
+}
+
+// jenis node selain tag validasi biasa
+const (
+	kindCheck     = "check"
+	kindOr        = "or"
+	kindOmitEmpty = "omitempty"
+	kindDive      = "dive"
+	kindKeys      = "keys"
+	kindEndKeys   = "endkeys"
+)
+
+// parseTag untuk memecah tag menjadi node, alias diganti dengan tag aslinya
+func (g *generator) parseTag(tag string) ([]node, error) {
+	if tag == "" {
+		return nil, nil
+	}
+
+	var chain []node
+	for _, segment := range strings.Split(tag, ",") {
+		name, param, _ := strings.Cut(segment, "=")
+
+		switch {
+		case segment == "":
+			return nil, fmt.Errorf("empty tag in %q", tag)
+		case name == kindOmitEmpty || name == kindDive || name == kindKeys || name == kindEndKeys:
+			chain = append(chain, node{kind: name})
+		case g.aliases[segment] != "":
+			expanded, err := g.parseTag(g.aliases[segment])
+			if err != nil {
+				return nil, fmt.Errorf("alias %s: %w", segment, err)
+			}
+
+			for _, item := range expanded {
+				if item.kind == kindOr {
+					return nil, fmt.Errorf("alias %s with | is not supported", segment)
+				}
+				item.alias = segment
+				chain = append(chain, item)
+			}
+		case strings.Contains(segment, "|"):
+			or := node{kind: kindOr}
+			for _, single := range strings.Split(segment, "|") {
+				name, param, _ := strings.Cut(single, "=")
+				or.alternatives = append(or.alternatives, node{kind: kindCheck, tag: name, param: param})
+			}
+			chain = append(chain, or)
+		default:
+			chain = append(chain, node{kind: kindCheck, tag: name, param: param})
+		}
+	}
+
+	return chain, nil
+}
+
+// value untuk membuat kode validasi satu value, urutannya sama dengan traverseField milik validator
+func (g *generator) value(current site, valueType types.Type, chain []node) (string, error) {
+	var code strings.Builder
+
+	if pointer, ok := valueType.Underlying().(*types.Pointer); ok {
+		deref := current
+		deref.expr, deref.fromPointer = "(*"+current.expr+")", true
+
+		// pointer yang tidak nil selalu lolos omitempty
+		rest := chain
+		if len(rest) > 0 && rest[0].kind == kindOmitEmpty {
+			rest = rest[1:]
+		}
+
+		inner, err := g.value(deref, pointer.Elem(), rest)
+		if err != nil {
+			return "", err
+		}
+
+		if len(chain) == 0 || chain[0].kind == kindOmitEmpty {
+			if inner != "" {
+				fmt.Fprintf(&code, "if %s != nil {\n%s}\n", current.expr, inner)
+			}
+			return code.String(), nil
+		}
+
+		// pointer nil gagal di tag pertama
+		first := chain[0]
+		if first.kind != kindCheck && first.kind != kindOr {
+			return "", fmt.Errorf("tag %s on nil pointer is not supported", first.kind)
+		}
+
+		fmt.Fprintf(&code, "if %s == nil {\n%s}", current.expr, g.appendError(current, first, current.expr))
+		if inner != "" {
+			fmt.Fprintf(&code, " else {\n%s}", inner)
+		}
+		code.WriteString("\n")
+		return code.String(), nil
+	}
+
+	nested, err := g.nestedStruct(valueType)
+	if err != nil {
+		return "", err
+	}
+
+	// required pada struct (bukan pointer) diabaikan, sama seperti validator tanpa WithRequiredStructEnabled
+	if nested != nil && len(chain) > 0 && chain[0].kind == kindCheck && chain[0].tag == "required" {
+		chain = chain[1:]
+	}
+
+	tail := func() (string, error) {
+		if nested == nil {
+			return "", nil
+		}
+		// method dengan pointer receiver bisa dipanggil langsung dari pointer tanpa (*)
+		receiver := strings.TrimSuffix(strings.TrimPrefix(current.expr, "(*"), ")")
+		return fmt.Sprintf("%s.validateNamespace(ctx, ns+%s, errs)\n", receiver, concat(current.name, `"."`)), nil
+	}
+
+	return g.tags(current, valueType, chain, tail)
+}
+
+// nestedStruct untuk mengecek value adalah struct di package yang sama, struct tersebut ikut dibuatkan method
+// time.Time bukan nested struct, struct dari package lain tidak didukung
+func (g *generator) nestedStruct(valueType types.Type) (*types.Named, error) {
+	if _, ok := valueType.Underlying().(*types.Struct); !ok {
+		return nil, nil
+	}
+
+	named, ok := valueType.(*types.Named)
+	if !ok {
+		return nil, fmt.Errorf("anonymous struct is not supported")
+	}
+
+	if isTime(named) {
+		return nil, nil
+	}
+
+	if named.Obj().Pkg() != g.pkg {
+		return nil, fmt.Errorf("struct %s from another package is not supported", named)
+	}
+
+	return named, g.enqueue(named)
+}
+
+// tags untuk membuat kode dari chain mulai dari node pertama, tail dipanggil jika semua tag lolos
+func (g *generator) tags(current site, valueType types.Type, chain []node, tail func() (string, error)) (string, error) {
+	if len(chain) == 0 {
+		return tail()
+	}
+
+	first, rest := chain[0], chain[1:]
+
+	switch first.kind {
+	case kindOmitEmpty:
+		inner, err := g.tags(current, valueType, rest, tail)
+		if err != nil || inner == "" {
+			return inner, err
+		}
+
+		condition, err := g.hasValue(current, valueType)
+		if err != nil {
+			return "", err
+		}
+		if condition == "true" {
+			return inner, nil
+		}
+		return fmt.Sprintf("if %s {\n%s}\n", condition, inner), nil
+	case kindDive:
+		return g.dive(current, valueType, rest)
+	case kindKeys, kindEndKeys:
+		return "", fmt.Errorf("%s must directly follow dive on a map", first.kind)
+	}
+
+	condition, err := g.condition(current, valueType, first)
+	if err != nil {
+		return "", err
+	}
+
+	inner, err := g.tags(current, valueType, rest, tail)
+	if err != nil {
+		return "", err
+	}
+
+	code := fmt.Sprintf("if %s {\n%s}", negate(condition), g.appendError(current, first, current.expr))
+	if inner != "" {
+		code += fmt.Sprintf(" else {\n%s}", inner)
+	}
+	return code + "\n", nil
+}
+
+// dive untuk membuat loop pada slice, array atau map, chain berlaku untuk setiap item
+func (g *generator) dive(current site, valueType types.Type, chain []node) (string, error) {
+	g.vars++
+	index, key, item := fmt.Sprintf("i%d", g.vars), fmt.Sprintf("key%d", g.vars), fmt.Sprintf("item%d", g.vars)
+
+	switch underlying := valueType.Underlying().(type) {
+	case *types.Slice, *types.Array:
+		elem := underlying.(interface{ Elem() types.Type }).Elem()
+
+		child := current
+		child.expr = current.expr + "[" + index + "]"
+		child.name = concat(current.name, `"["`, g.use("strconv")+".Itoa("+index+")", `"]"`)
+		child.fromPointer = false
+
+		inner, err := g.value(child, elem, chain)
+		if err != nil || inner == "" {
+			return inner, err
+		}
+		return fmt.Sprintf("for %s := range %s {\n%s}\n", index, current.expr, inner), nil
+	case *types.Map:
+		// nama item map memakai fmt.Sprint dari key, key string bisa langsung dipakai
+		keyName := key
+		if !types.Identical(underlying.Key(), types.Typ[types.String]) {
+			keyName = g.use("fmt") + ".Sprint(" + key + ")"
+		}
+		name := concat(current.name, `"["`, keyName, `"]"`)
+
+		keyChain, valueChain := []node(nil), chain
+		if len(chain) > 0 && chain[0].kind == kindKeys {
+			end := slices.IndexFunc(chain, func(item node) bool { return item.kind == kindEndKeys })
+			if end < 0 {
+				return "", fmt.Errorf("keys without endkeys")
+			}
+
+			// tanpa tag setelah endkeys, value dari map tidak divalidasi sama sekali
+			keyChain, valueChain = chain[1:end], chain[end+1:]
+			if len(valueChain) == 0 {
+				valueChain = nil
+			}
+		}
+
+		var inner strings.Builder
+		if keyChain != nil {
+			code, err := g.value(site{expr: key, name: name, parent: current.parent, parentType: current.parentType}, underlying.Key(), keyChain)
+			if err != nil {
+				return "", err
+			}
+			inner.WriteString(code)
+		}
+
+		if keyChain == nil || valueChain != nil {
+			code, err := g.value(site{expr: item, name: name, parent: current.parent, parentType: current.parentType}, underlying.Elem(), valueChain)
+			if err != nil {
+				return "", err
+			}
+			inner.WriteString(code)
+		}
+
+		if inner.Len() == 0 {
+			return "", nil
+		}
+
+		body := inner.String()
+		keyVar, itemVar := key, item
+		if !usesVar(body, key) {
+			keyVar = "_"
+		}
+		if !usesVar(body, item) {
+			return fmt.Sprintf("for %s := range %s {\n%s}\n", keyVar, current.expr, body), nil
+		}
+		return fmt.Sprintf("for %s, %s := range %s {\n%s}\n", keyVar, itemVar, current.expr, body), nil
+	}
+
+	return "", fmt.Errorf("dive on %s, want slice, array or map", valueType)
+}
+
+// appendError untuk membuat kode yang menambahkan error dari node yang gagal
+func (g *generator) appendError(current site, failed node, value string) string {
+	tag, actualTag, param := failed.tag, failed.tag, failed.param
+
+	if failed.kind == kindOr {
+		// sama seperti validator, tag berisi semua alternatif beserta parameternya
+		var joined []string
+		for _, alternative := range failed.alternatives {
+			single := alternative.tag
+			if alternative.param != "" {
+				single += "=" + alternative.param
+			}
+			joined = append(joined, single)
+		}
+		tag = strings.Join(joined, "|")
+		actualTag = tag
+		param = failed.alternatives[len(failed.alternatives)-1].param
+	}
+
+	if failed.alias != "" {
+		tag = failed.alias
+	}
+
+	return fmt.Sprintf("*errs = append(*errs, %s.NewFieldError(ns+%s, %s, %q, %q, %q, %s))\n",
+		g.use("go-validation/validation"), current.name, current.name, tag, actualTag, param, value)
+}
+
+// hasValue untuk expression yang true jika value tidak kosong, sama seperti tag required
+func (g *generator) hasValue(current site, valueType types.Type) (string, error) {
+	switch underlying := valueType.Underlying().(type) {
+	case *types.Slice, *types.Map, *types.Pointer, *types.Interface, *types.Chan, *types.Signature:
+		return current.expr + " != nil", nil
+	case *types.Basic:
+		if current.fromPointer {
+			return "true", nil
+		}
+
+		info := underlying.Info()
+		switch {
+		case info&types.IsString != 0:
+			return current.expr + ` != ""`, nil
+		case info&types.IsNumeric != 0:
+			return current.expr + " != 0", nil
+		case info&types.IsBoolean != 0:
+			return current.expr, nil
+		}
+	case *types.Struct:
+		if current.fromPointer {
+			return "true", nil
+		}
+	}
+
+	return "", fmt.Errorf("required or omitempty on %s is not supported", valueType)
+}
+
+// condition untuk expression yang true jika value lolos satu tag
+func (g *generator) condition(current site, valueType types.Type, check node) (string, error) {
+	if check.kind == kindOr {
+		var conditions []string
+		for _, alternative := range check.alternatives {
+			condition, err := g.condition(current, valueType, alternative)
+			if err != nil {
+				return "", err
+			}
+			if strings.Contains(condition, " ") {
+				condition = "(" + condition + ")"
+			}
+			conditions = append(conditions, condition)
+		}
+		return strings.Join(conditions, " || "), nil
+	}
+
+	expr := current.expr
+	basic, _ := valueType.Underlying().(*types.Basic)
+	isString := basic != nil && basic.Info()&types.IsString != 0
+	isPlainString := types.Identical(valueType, types.Typ[types.String])
+
+	switch check.tag {
+	case "required":
+		return g.hasValue(current, valueType)
+	case "min", "gte", "max", "lte", "gt", "lt", "len":
+		operator := map[string]string{"min": ">=", "gte": ">=", "max": "<=", "lte": "<=", "gt": ">", "lt": "<", "len": "=="}[check.tag]
+		return g.compare(expr, valueType, check, operator)
+	case "eq", "ne":
+		operator := map[string]string{"eq": "==", "ne": "!="}[check.tag]
+		if isString {
+			return fmt.Sprintf("%s %s %q", stringExpr(expr, valueType), operator, check.param), nil
+		}
+		if basic != nil && basic.Info()&types.IsBoolean != 0 {
+			value, err := strconv.ParseBool(check.param)
+			if err != nil {
+				return "", fmt.Errorf("invalid param %q for tag %s", check.param, check.tag)
+			}
+			return fmt.Sprintf("%s %s %t", expr, operator, value), nil
+		}
+		return g.compare(expr, valueType, check, operator)
+	case "email", "alpha", "alphanum", "ip", "ipv4", "ipv6":
+		if !isString {
+			return "", fmt.Errorf("tag %s on %s is not supported", check.tag, valueType)
+		}
+		function := map[string]string{
+			"email": "IsEmail", "alpha": "IsAlpha", "alphanum": "IsAlphanum",
+			"ip": "IsIP", "ipv4": "IsIPv4", "ipv6": "IsIPv6",
+		}[check.tag]
+		return fmt.Sprintf("%s.%s(%s)", g.use("go-validation/validation"), function, stringExpr(expr, valueType)), nil
+	case "numeric":
+		if isString {
+			return fmt.Sprintf("%s.IsNumeric(%s)", g.use("go-validation/validation"), stringExpr(expr, valueType)), nil
+		}
+		if basic != nil && basic.Info()&types.IsNumeric != 0 {
+			return "true", nil
+		}
+	case "oneof":
+		return g.oneOf(expr, valueType, check.param)
+	case "eqfield", "nefield":
+		return g.fieldCompare(current, valueType, check)
+	case validation.TagCategory, validation.TagGender, validation.TagInSet:
+		// custom tag project hanya menerima type string, type lain selalu gagal
+		if !isPlainString {
+			return "false", nil
+		}
+		set := map[string]string{validation.TagCategory: validation.SetCategory, validation.TagGender: validation.SetGender}[check.tag]
+		if check.tag == validation.TagInSet {
+			set = check.param
+		}
+		return fmt.Sprintf("%s.InSet(%q, %s)", g.use("go-validation/validation"), set, expr), nil
+	case validation.TagMinCategory:
+		length, err := validation.IntParam.Parse(check.param)
+		if err != nil {
+			return "", &validation.ParamError{Tag: check.tag, Param: check.param, Type: validation.IntParam.Name, Err: err}
+		}
+		if !types.Identical(valueType, types.NewSlice(types.Typ[types.String])) {
+			return "false", nil
+		}
+		return fmt.Sprintf("%s.HasMinCategory(%s, %d)", g.use("go-validation/validation"), expr, length), nil
+	default:
+		return "", fmt.Errorf("tag %s is not supported by validategen", check.tag)
+	}
+
+	return "", fmt.Errorf("tag %s on %s is not supported", check.tag, valueType)
+}
+
+// compare untuk tag min, max, len dan sejenisnya
+// string dibandingkan jumlah karakternya, slice dan map jumlah itemnya, angka nilainya
+func (g *generator) compare(expr string, valueType types.Type, check node, operator string) (string, error) {
+	invalid := fmt.Errorf("invalid param %q for tag %s on %s", check.param, check.tag, valueType)
+
+	switch underlying := valueType.Underlying().(type) {
+	case *types.Slice, *types.Map, *types.Array:
+		length, err := strconv.ParseInt(check.param, 0, 64)
+		if err != nil {
+			return "", invalid
+		}
+		return fmt.Sprintf("len(%s) %s %d", expr, operator, length), nil
+	case *types.Basic:
+		info := underlying.Info()
+		switch {
+		case info&types.IsString != 0:
+			length, err := strconv.ParseInt(check.param, 0, 64)
+			if err != nil {
+				return "", invalid
+			}
+			return fmt.Sprintf("%s.RuneCountInString(%s) %s %d", g.use("unicode/utf8"), stringExpr(expr, valueType), operator, length), nil
+		case info&types.IsUnsigned != 0:
+			value, err := strconv.ParseUint(check.param, 0, 64)
+			if err != nil {
+				return "", invalid
+			}
+			return fmt.Sprintf("uint64(%s) %s %d", expr, operator, value), nil
+		case info&types.IsInteger != 0:
+			value, err := parseInt(check.param, valueType)
+			if err != nil {
+				return "", invalid
+			}
+			return fmt.Sprintf("int64(%s) %s %d", expr, operator, value), nil
+		case info&types.IsFloat != 0:
+			value, err := strconv.ParseFloat(check.param, 64)
+			if err != nil {
+				return "", invalid
+			}
+			return fmt.Sprintf("float64(%s) %s %s", expr, operator, strconv.FormatFloat(value, 'g', -1, 64)), nil
+		}
+	}
+
+	return "", fmt.Errorf("tag %s on %s is not supported", check.tag, valueType)
+}
+
+// oneOf untuk tag oneof, value di dalam petik tunggal boleh berisi spasi
+func (g *generator) oneOf(expr string, valueType types.Type, param string) (string, error) {
+	basic, ok := valueType.Underlying().(*types.Basic)
+	if !ok {
+		return "", fmt.Errorf("tag oneof on non basic type is not supported")
+	}
+
+	var values []string
+	for _, value := range splitOneOf(param) {
+		info := basic.Info()
+		switch {
+		case info&types.IsString != 0:
+			values = append(values, fmt.Sprintf("%s == %q", stringExpr(expr, valueType), value))
+		case info&types.IsUnsigned != 0:
+			// value yang bukan angka tidak pernah sama dengan field
+			if number, err := strconv.ParseUint(value, 10, 64); err == nil {
+				values = append(values, fmt.Sprintf("uint64(%s) == %d", expr, number))
+			}
+		case info&types.IsInteger != 0:
+			if number, err := strconv.ParseInt(value, 10, 64); err == nil {
+				values = append(values, fmt.Sprintf("int64(%s) == %d", expr, number))
+			}
+		default:
+			return "", fmt.Errorf("tag oneof on %s is not supported", basic)
+		}
+	}
+
+	if len(values) == 0 {
+		return "false", nil
+	}
+	return strings.Join(values, " || "), nil
+}
+
+// fieldCompare untuk tag eqfield dan nefield dengan field lain di struct yang sama
+func (g *generator) fieldCompare(current site, valueType types.Type, check node) (string, error) {
+	if current.parentType == nil || strings.Contains(check.param, ".") {
+		return "", fmt.Errorf("tag %s=%s is not supported", check.tag, check.param)
+	}
+
+	var other *types.Var
+	for i := 0; i < current.parentType.NumFields(); i++ {
+		if current.parentType.Field(i).Name() == check.param {
+			other = current.parentType.Field(i)
+		}
+	}
+
+	// field yang tidak ada atau kind nya berbeda : eqfield gagal dan nefield lolos
+	mismatch := map[string]string{"eqfield": "false", "nefield": "true"}[check.tag]
+	if other == nil {
+		return mismatch, nil
+	}
+
+	basic, ok := valueType.Underlying().(*types.Basic)
+	otherBasic, otherOk := other.Type().Underlying().(*types.Basic)
+	if !ok || !otherOk {
+		return "", fmt.Errorf("tag %s on %s is not supported", check.tag, valueType)
+	}
+
+	if basic.Kind() != otherBasic.Kind() {
+		return mismatch, nil
+	}
+
+	operator := map[string]string{"eqfield": "==", "nefield": "!="}[check.tag]
+	if types.Identical(valueType, other.Type()) {
+		return fmt.Sprintf("%s %s %s.%s", current.expr, operator, current.parent, other.Name()), nil
+	}
+	return fmt.Sprintf("%s(%s) %s %s(%s.%s)", basic.Name(), current.expr, operator, basic.Name(), current.parent, other.Name()), nil
+}
+
+// parseInt untuk parameter angka, time.Duration boleh ditulis sebagai durasi seperti 1h
+func parseInt(param string, valueType types.Type) (int64, error) {
+	if named, ok := valueType.(*types.Named); ok && named.Obj().Pkg() != nil &&
+		named.Obj().Pkg().Path() == "time" && named.Obj().Name() == "Duration" {
+		if duration, err := time.ParseDuration(param); err == nil {
+			return int64(duration), nil
+		}
+	}
+
+	return strconv.ParseInt(param, 0, 64)
+}
+
+// splitOneOf untuk memisahkan parameter oneof dengan spasi, sama seperti validator
+func splitOneOf(param string) []string {
+	var values []string
+	for len(param) > 0 {
+		param = strings.TrimLeft(param, " \t\n")
+		if param == "" {
+			break
+		}
+
+		if param[0] == '\'' {
+			if end := strings.IndexByte(param[1:], '\''); end >= 0 {
+				values = append(values, param[1:end+1])
+				param = param[end+2:]
+				continue
+			}
+		}
+
+		end := strings.IndexAny(param, " \t\n")
+		if end < 0 {
+			end = len(param)
+		}
+		values = append(values, param[:end])
+		param = param[end:]
+	}
+
+	return values
+}
+
+// usesVar untuk mengecek variabel dipakai di dalam kode, item1 tidak dianggap sama dengan item10
+func usesVar(code, name string) bool {
+	return regexp.MustCompile(`\b` + name + `\b`).MatchString(code)
+}
+
+// comparison adalah condition berupa satu perbandingan, contoh : len(s.Name) >= 2
+var comparison = regexp.MustCompile(`^([^|&]+?) (==|!=|>=|<=|>|<) ([^|&]+)$`)
+
+// call adalah condition berupa satu pemanggilan function, contoh : validation.IsEmail(s.Email)
+var call = regexp.MustCompile(`^[\w.]+\(.*\)$`)
+
+// plainOperand untuk mengecek operand tidak berisi string yang bisa mengandung operator, kecuali literal string
+func plainOperand(operand string) bool {
+	if _, err := strconv.Unquote(operand); err == nil {
+		return true
+	}
+	return !strings.Contains(operand, `"`)
+}
+
+// negate untuk membalik condition supaya kode hasil generate mudah dibaca
+func negate(condition string) string {
+	inverse := map[string]string{"==": "!=", "!=": "==", ">=": "<", "<=": ">", ">": "<=", "<": ">="}
+
+	switch match := comparison.FindStringSubmatch(condition); {
+	case condition == "true":
+		return "false"
+	case condition == "false":
+		return "true"
+	case match != nil && plainOperand(match[1]) && plainOperand(match[3]):
+		return match[1] + " " + inverse[match[2]] + " " + match[3]
+	case !strings.Contains(condition, " || ") && !strings.Contains(condition, " && ") && call.MatchString(condition):
+		return "!" + condition
+	}
+
+	return "!(" + condition + ")"
+}
+
+// stringExpr untuk expression string dari value, type turunan string dikonversi dulu
+func stringExpr(expr string, valueType types.Type) string {
+	if types.Identical(valueType, types.Typ[types.String]) {
+		return expr
+	}
+	return "string(" + expr + ")"
+}
+
+// isTime untuk mengecek type adalah time.Time
+func isTime(named *types.Named) bool {
+	return named.Obj().Pkg() != nil && named.Obj().Pkg().Path() == "time" && named.Obj().Name() == "Time"
+}
+
+// concat untuk menggabungkan expression string, literal yang berdampingan digabung langsung
+func concat(parts ...string) string {
+	var split []string
+	for _, part := range parts {
+		split = append(split, strings.Split(part, " + ")...)
+	}
+
+	var merged []string
+	for _, part := range split {
+		if last := len(merged) - 1; last >= 0 {
+			left, leftErr := strconv.Unquote(merged[last])
+			right, rightErr := strconv.Unquote(part)
+			if leftErr == nil && rightErr == nil {
+				merged[last] = strconv.Quote(left + right)
+				continue
+			}
+		}
+		merged = append(merged, part)
+	}
+
+	return strings.Join(merged, " + ")
+}
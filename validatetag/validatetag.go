@@ -0,0 +1,350 @@
+// Package validatetag berisi analyzer go/analysis untuk mengecek tag validate pada struct saat compile
+// tag yang salah ketik (requried), parameter yang salah (min=abc), dive pada field yang bukan slice atau map,
+// keys tanpa endkeys dan eqfield ke field yang tidak ada dilaporkan tanpa harus menjalankan validasi
+//
+// contoh menjalankan analyzer bersama go vet :
+//
+//	go build -o validatetag ./cmd/validatetag
+//	go vet -vettool=$(pwd)/validatetag ./...
+package validatetag
+
+import (
+	"context"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go-validation/validation"
+
+	"github.com/go-playground/validator/v10"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+// Analyzer mengecek tag validate dengan tag bawaan validator dan custom tag dari validation.New()
+var Analyzer = New()
+
+// lengthTags adalah tag bawaan yang parameternya berupa angka, atau panjang untuk string, slice dan map
+var lengthTags = map[string]bool{
+	"min": true, "max": true, "len": true, "gt": true, "gte": true, "lt": true, "lte": true,
+}
+
+// valueTags adalah tag bawaan yang parameternya berupa angka kecuali untuk field string
+var valueTags = map[string]bool{
+	"eq": true, "ne": true,
+}
+
+// fieldTags adalah tag bawaan yang parameternya berupa nama field di struct yang sama
+var fieldTags = map[string]bool{
+	"eqfield": true, "nefield": true, "gtfield": true, "gtefield": true, "ltfield": true, "ltefield": true,
+	"fieldcontains": true, "fieldexcludes": true,
+	"required_with": true, "required_with_all": true, "required_without": true, "required_without_all": true,
+	"excluded_with": true, "excluded_with_all": true, "excluded_without": true, "excluded_without_all": true,
+}
+
+// conditionTags adalah tag bawaan yang parameternya berupa pasangan nama field dan value
+var conditionTags = map[string]bool{
+	"required_if": true, "required_unless": true, "excluded_if": true, "excluded_unless": true,
+}
+
+// checker menyimpan konfigurasi dari satu Analyzer
+type checker struct {
+	tagName string
+	extra   string
+	options []validation.Option
+
+	once  sync.Once
+	probe *validator.Validate
+	err   error
+}
+
+// New untuk membuat analyzer dengan option tambahan untuk validator, misal custom tag milik project lain
+// contoh : validatetag.New(validation.WithValidation("even", ValidateEven))
+func New(options ...validation.Option) *analysis.Analyzer {
+	checker := &checker{options: options}
+
+	analyzer := &analysis.Analyzer{
+		Name:     "validatetag",
+		Doc:      "check validate struct tags for unknown tags, invalid params and misplaced dive, keys and field references",
+		Requires: []*analysis.Analyzer{inspect.Analyzer},
+		Run:      checker.run,
+	}
+	analyzer.Flags.StringVar(&checker.tagName, "tag", "validate", "name of the struct tag to check")
+	analyzer.Flags.StringVar(&checker.extra, "extra", "", "comma separated custom tags that are registered outside validation.New")
+
+	return analyzer
+}
+
+// run untuk mengecek setiap struct di dalam package
+func (c *checker) run(pass *analysis.Pass) (any, error) {
+	c.once.Do(func() {
+		options := c.options
+		for _, tag := range strings.Split(c.extra, ",") {
+			if tag = strings.TrimSpace(tag); tag != "" {
+				options = append(options, validation.WithValidation(tag, func(validator.FieldLevel) bool { return true }))
+			}
+		}
+		c.probe, c.err = validation.New(options...)
+	})
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	inspect.Preorder([]ast.Node{(*ast.StructType)(nil)}, func(node ast.Node) {
+		structNode := node.(*ast.StructType)
+		structType, ok := pass.TypesInfo.TypeOf(structNode).(*types.Struct)
+		if !ok {
+			return
+		}
+
+		// satu baris field bisa berisi beberapa nama, urutannya sama dengan field di types.Struct
+		index := 0
+		for _, field := range structNode.Fields.List {
+			if field.Tag != nil {
+				for i := 0; i < max(1, len(field.Names)); i++ {
+					c.checkField(pass, structType, structType.Field(index+i), field.Tag)
+				}
+			}
+			index += max(1, len(field.Names))
+		}
+	})
+
+	return nil, nil
+}
+
+// segment adalah satu bagian dari tag yang dipisah koma beserta posisinya
+type segment struct {
+	value string
+	pos   token.Pos
+}
+
+// checkField untuk mengecek tag validate dari satu field
+func (c *checker) checkField(pass *analysis.Pass, structType *types.Struct, field *types.Var, literal *ast.BasicLit) {
+	raw, err := strconv.Unquote(literal.Value)
+	if err != nil {
+		return
+	}
+
+	tag, ok := reflect.StructTag(raw).Lookup(c.tagName)
+	if !ok || tag == "" || tag == "-" {
+		return
+	}
+
+	start, exact := tagPos(literal, raw, c.tagName)
+	segments := splitTag(tag, start, exact)
+
+	current := field.Type()
+	var diveMap *types.Map
+	var keys *segment
+
+	for i, seg := range segments {
+		name, _, _ := strings.Cut(seg.value, "=")
+
+		switch name {
+		case "dive":
+			elem, mapType, ok := elemType(current)
+			if !ok {
+				pass.Reportf(seg.pos, "field %s: dive on type %s, want slice, array or map", field.Name(), current)
+				return
+			}
+			current, diveMap = elem, mapType
+		case "keys":
+			if i == 0 || segments[i-1].value != "dive" || diveMap == nil {
+				pass.Reportf(seg.pos, "field %s: keys must directly follow dive on a map", field.Name())
+				return
+			}
+			keys, current = &segments[i], diveMap.Key()
+		case "endkeys":
+			if keys == nil {
+				pass.Reportf(seg.pos, "field %s: endkeys without keys", field.Name())
+				return
+			}
+			keys, current = nil, diveMap.Elem()
+		default:
+			for _, alternative := range strings.Split(seg.value, "|") {
+				if message := c.checkSingle(structType, current, alternative); message != "" {
+					pass.Reportf(seg.pos, "field %s: %s", field.Name(), message)
+				}
+			}
+		}
+	}
+
+	if keys != nil {
+		pass.Reportf(keys.pos, "field %s: keys without endkeys", field.Name())
+	}
+}
+
+// checkSingle untuk mengecek satu tag tanpa koma dan pipe, kosong jika tag valid
+func (c *checker) checkSingle(structType *types.Struct, fieldType types.Type, single string) string {
+	name, param, _ := strings.Cut(single, "=")
+
+	if message := c.checkDefined(single); message != "" {
+		return message
+	}
+
+	if err := validation.CheckTag(c.probe, single); err != nil {
+		return err.Error()
+	}
+
+	switch {
+	case lengthTags[name] || valueTags[name]:
+		if param != "" && !validNumber(name, param, fieldType) {
+			return fmt.Sprintf("invalid param %q for tag %s on %s", param, name, fieldType)
+		}
+	case fieldTags[name]:
+		for _, path := range strings.Fields(param) {
+			if !hasField(structType, path) {
+				return fmt.Sprintf("tag %s refers to missing field %q", name, path)
+			}
+		}
+	case conditionTags[name]:
+		words := strings.Fields(param)
+		for i := 0; i < len(words); i += 2 {
+			if !hasField(structType, words[i]) {
+				return fmt.Sprintf("tag %s refers to missing field %q", name, words[i])
+			}
+		}
+	}
+
+	return ""
+}
+
+// checkDefined untuk mengecek tag sudah didaftarkan di validator
+// value nil membuat validator hanya melakukan parsing tag tanpa menjalankan function validasinya
+func (c *checker) checkDefined(single string) (message string) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			name, _, _ := strings.Cut(single, "=")
+			message = fmt.Sprintf("unknown tag %q", name)
+			if text := fmt.Sprint(recovered); !strings.Contains(text, "Undefined validation function") {
+				message = fmt.Sprintf("invalid tag %q: %s", single, text)
+			}
+		}
+	}()
+
+	_ = c.probe.VarCtx(context.Background(), nil, single)
+	return ""
+}
+
+// splitTag untuk memisahkan tag dengan koma beserta posisi setiap bagian
+// jika posisi tag di dalam literal tidak diketahui, semua bagian memakai posisi literal
+func splitTag(tag string, start token.Pos, exact bool) []segment {
+	var segments []segment
+
+	offset := 0
+	for _, value := range strings.Split(tag, ",") {
+		pos := start
+		if exact {
+			pos += token.Pos(offset)
+		}
+		segments = append(segments, segment{value: value, pos: pos})
+		offset += len(value) + 1
+	}
+
+	return segments
+}
+
+// tagPos untuk mencari posisi awal value tag di dalam literal
+// posisi yang tepat hanya bisa dihitung untuk raw string, selain itu dipakai posisi literal
+func tagPos(literal *ast.BasicLit, raw, tagName string) (token.Pos, bool) {
+	if !strings.HasPrefix(literal.Value, "`") {
+		return literal.Pos(), false
+	}
+
+	key := tagName + `:"`
+	for offset := 0; ; {
+		index := strings.Index(raw[offset:], key)
+		if index < 0 {
+			return literal.Pos(), false
+		}
+
+		index += offset
+		if index == 0 || raw[index-1] == ' ' {
+			return literal.Pos() + token.Pos(1+index+len(key)), true
+		}
+		offset = index + len(key)
+	}
+}
+
+// elemType untuk mengambil type item dari slice, array atau map, map dikembalikan untuk keys
+func elemType(fieldType types.Type) (types.Type, *types.Map, bool) {
+	switch underlying := deref(fieldType).Underlying().(type) {
+	case *types.Slice:
+		return underlying.Elem(), nil, true
+	case *types.Array:
+		return underlying.Elem(), nil, true
+	case *types.Map:
+		return underlying.Elem(), underlying, true
+	}
+
+	return nil, nil, false
+}
+
+// deref untuk mengambil type dari pointer
+func deref(fieldType types.Type) types.Type {
+	for {
+		pointer, ok := fieldType.Underlying().(*types.Pointer)
+		if !ok {
+			return fieldType
+		}
+		fieldType = pointer.Elem()
+	}
+}
+
+// hasField untuk mengecek path field (misal Inner.Name) ada di struct
+func hasField(structType *types.Struct, path string) bool {
+	var current types.Type = structType
+	for _, name := range strings.Split(path, ".") {
+		object, _, _ := types.LookupFieldOrMethod(current, true, nil, name)
+		field, ok := object.(*types.Var)
+		if !ok || !field.IsField() {
+			return false
+		}
+		current = field.Type()
+	}
+
+	return true
+}
+
+// validNumber untuk mengecek parameter angka sesuai type field, sama seperti cara validator membaca parameter
+func validNumber(tag, param string, fieldType types.Type) bool {
+	if named, ok := deref(fieldType).(*types.Named); ok && named.Obj().Pkg() != nil &&
+		named.Obj().Pkg().Path() == "time" && named.Obj().Name() == "Duration" {
+		if _, err := time.ParseDuration(param); err == nil {
+			return true
+		}
+		_, err := strconv.ParseInt(param, 0, 64)
+		return err == nil
+	}
+
+	var err error
+	switch underlying := deref(fieldType).Underlying().(type) {
+	case *types.Basic:
+		info := underlying.Info()
+		switch {
+		case info&types.IsString != 0:
+			if valueTags[tag] {
+				return true
+			}
+			_, err = strconv.ParseInt(param, 0, 64)
+		case info&types.IsUnsigned != 0:
+			_, err = strconv.ParseUint(param, 0, 64)
+		case info&types.IsInteger != 0:
+			_, err = strconv.ParseInt(param, 0, 64)
+		case info&types.IsFloat != 0:
+			_, err = strconv.ParseFloat(param, 64)
+		}
+	case *types.Slice, *types.Array, *types.Map:
+		_, err = strconv.ParseInt(param, 0, 64)
+	}
+
+	return err == nil
+}
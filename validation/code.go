@@ -0,0 +1,261 @@
+package validation
+
+import (
+	"fmt"
+	"maps"
+	"reflect"
+	"sync"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// CodeInvalid dipakai untuk tag yang belum punya error code
+const CodeInvalid = "INVALID_VALUE"
+
+// codes berisi error code yang stabil untuk setiap tag, code yang sudah ada tidak boleh diubah
+// key dengan suffix .string, .items atau .number dipakai sesuai kind dari field yang divalidasi
+var (
+	codesMutex sync.RWMutex
+	codes      = map[string]string{
+		"required":                      "FIELD_REQUIRED",
+		"min.string":                    "FIELD_TOO_SHORT",
+		"min.items":                     "TOO_FEW_ITEMS",
+		"min.number":                    "VALUE_TOO_SMALL",
+		"max.string":                    "FIELD_TOO_LONG",
+		"max.items":                     "TOO_MANY_ITEMS",
+		"max.number":                    "VALUE_TOO_LARGE",
+		"len.string":                    "INVALID_LENGTH",
+		"len.items":                     "INVALID_ITEM_COUNT",
+		"len.number":                    "VALUE_NOT_EQUAL",
+		"eq":                            "VALUE_NOT_EQUAL",
+		"ne":                            "VALUE_NOT_ALLOWED",
+		"gt":                            "VALUE_TOO_SMALL",
+		"gte":                           "VALUE_TOO_SMALL",
+		"lt":                            "VALUE_TOO_LARGE",
+		"lte":                           "VALUE_TOO_LARGE",
+		"gt.string":                     "FIELD_TOO_SHORT",
+		"gt.items":                      "TOO_FEW_ITEMS",
+		"gte.string":                    "FIELD_TOO_SHORT",
+		"gte.items":                     "TOO_FEW_ITEMS",
+		"lt.string":                     "FIELD_TOO_LONG",
+		"lt.items":                      "TOO_MANY_ITEMS",
+		"lte.string":                    "FIELD_TOO_LONG",
+		"lte.items":                     "TOO_MANY_ITEMS",
+		"eq.items":                      "INVALID_ITEM_COUNT",
+		"ne.items":                      "INVALID_ITEM_COUNT",
+		"eqfield":                       "FIELD_MISMATCH",
+		"nefield":                       "FIELD_MUST_DIFFER",
+		"email":                         "INVALID_EMAIL",
+		"ip":                            "INVALID_IP_ADDRESS",
+		"ipv4":                          "INVALID_IP_ADDRESS",
+		"ipv6":                          "INVALID_IP_ADDRESS",
+		"url":                           "INVALID_URL",
+		"uuid":                          "INVALID_UUID",
+		"alpha":                         "INVALID_ALPHA",
+		"alphanum":                      "INVALID_ALPHANUMERIC",
+		"numeric":                       "INVALID_NUMERIC",
+		"oneof":                         "VALUE_NOT_ALLOWED",
+		"required_if":                   "FIELD_REQUIRED",
+		"required_unless":               "FIELD_REQUIRED",
+		"skip_unless":                   "FIELD_REQUIRED",
+		"required_with":                 "FIELD_REQUIRED",
+		"required_with_all":             "FIELD_REQUIRED",
+		"required_without":              "FIELD_REQUIRED",
+		"required_without_all":          "FIELD_REQUIRED",
+		"excluded_if":                   "FIELD_NOT_ALLOWED",
+		"excluded_unless":               "FIELD_NOT_ALLOWED",
+		"excluded_with":                 "FIELD_NOT_ALLOWED",
+		"excluded_with_all":             "FIELD_NOT_ALLOWED",
+		"excluded_without":              "FIELD_NOT_ALLOWED",
+		"excluded_without_all":          "FIELD_NOT_ALLOWED",
+		"isdefault":                     "FIELD_NOT_ALLOWED",
+		"eq_ignore_case":                "VALUE_NOT_EQUAL",
+		"ne_ignore_case":                "VALUE_NOT_ALLOWED",
+		"eqcsfield":                     "FIELD_MISMATCH",
+		"necsfield":                     "FIELD_MUST_DIFFER",
+		"gtfield":                       "VALUE_TOO_SMALL",
+		"gtcsfield":                     "VALUE_TOO_SMALL",
+		"gtefield":                      "VALUE_TOO_SMALL",
+		"gtecsfield":                    "VALUE_TOO_SMALL",
+		"ltfield":                       "VALUE_TOO_LARGE",
+		"ltcsfield":                     "VALUE_TOO_LARGE",
+		"ltefield":                      "VALUE_TOO_LARGE",
+		"ltecsfield":                    "VALUE_TOO_LARGE",
+		"fieldcontains":                 "INVALID_CONTENT",
+		"fieldexcludes":                 "INVALID_CONTENT",
+		"alphaunicode":                  "INVALID_ALPHA",
+		"alphanumunicode":               "INVALID_ALPHANUMERIC",
+		"boolean":                       "INVALID_BOOLEAN",
+		"number":                        "INVALID_NUMBER",
+		"hexadecimal":                   "INVALID_HEXADECIMAL",
+		"hexcolor":                      "INVALID_COLOR",
+		"rgb":                           "INVALID_COLOR",
+		"rgba":                          "INVALID_COLOR",
+		"hsl":                           "INVALID_COLOR",
+		"hsla":                          "INVALID_COLOR",
+		"iscolor":                       "INVALID_COLOR",
+		"e164":                          "INVALID_PHONE_NUMBER",
+		"http_url":                      "INVALID_URL",
+		"uri":                           "INVALID_URI",
+		"urn_rfc2141":                   "INVALID_URN",
+		"file":                          "FILE_NOT_FOUND",
+		"filepath":                      "INVALID_FILE_PATH",
+		"dir":                           "DIRECTORY_NOT_FOUND",
+		"dirpath":                       "INVALID_DIRECTORY_PATH",
+		"image":                         "INVALID_IMAGE",
+		"base64":                        "INVALID_BASE64",
+		"base64url":                     "INVALID_BASE64",
+		"base64rawurl":                  "INVALID_BASE64",
+		"contains":                      "INVALID_CONTENT",
+		"containsany":                   "INVALID_CONTENT",
+		"containsrune":                  "INVALID_CONTENT",
+		"excludes":                      "INVALID_CONTENT",
+		"excludesall":                   "INVALID_CONTENT",
+		"excludesrune":                  "INVALID_CONTENT",
+		"startswith":                    "INVALID_PREFIX",
+		"startsnotwith":                 "INVALID_PREFIX",
+		"endswith":                      "INVALID_SUFFIX",
+		"endsnotwith":                   "INVALID_SUFFIX",
+		"isbn":                          "INVALID_ISBN",
+		"isbn10":                        "INVALID_ISBN",
+		"isbn13":                        "INVALID_ISBN",
+		"issn":                          "INVALID_ISSN",
+		"eth_addr":                      "INVALID_ETH_ADDRESS",
+		"eth_addr_checksum":             "INVALID_ETH_ADDRESS",
+		"btc_addr":                      "INVALID_BTC_ADDRESS",
+		"btc_addr_bech32":               "INVALID_BTC_ADDRESS",
+		"uuid3":                         "INVALID_UUID",
+		"uuid4":                         "INVALID_UUID",
+		"uuid5":                         "INVALID_UUID",
+		"uuid_rfc4122":                  "INVALID_UUID",
+		"uuid3_rfc4122":                 "INVALID_UUID",
+		"uuid4_rfc4122":                 "INVALID_UUID",
+		"uuid5_rfc4122":                 "INVALID_UUID",
+		"ulid":                          "INVALID_ULID",
+		"md4":                           "INVALID_HASH",
+		"md5":                           "INVALID_HASH",
+		"sha256":                        "INVALID_HASH",
+		"sha384":                        "INVALID_HASH",
+		"sha512":                        "INVALID_HASH",
+		"ripemd128":                     "INVALID_HASH",
+		"ripemd160":                     "INVALID_HASH",
+		"tiger128":                      "INVALID_HASH",
+		"tiger160":                      "INVALID_HASH",
+		"tiger192":                      "INVALID_HASH",
+		"ascii":                         "INVALID_ASCII",
+		"printascii":                    "INVALID_ASCII",
+		"multibyte":                     "INVALID_MULTIBYTE",
+		"datauri":                       "INVALID_DATA_URI",
+		"latitude":                      "INVALID_LATITUDE",
+		"longitude":                     "INVALID_LONGITUDE",
+		"ssn":                           "INVALID_SSN",
+		"cidr":                          "INVALID_CIDR",
+		"cidrv4":                        "INVALID_CIDR",
+		"cidrv6":                        "INVALID_CIDR",
+		"tcp_addr":                      "INVALID_NETWORK_ADDRESS",
+		"tcp4_addr":                     "INVALID_NETWORK_ADDRESS",
+		"tcp6_addr":                     "INVALID_NETWORK_ADDRESS",
+		"udp_addr":                      "INVALID_NETWORK_ADDRESS",
+		"udp4_addr":                     "INVALID_NETWORK_ADDRESS",
+		"udp6_addr":                     "INVALID_NETWORK_ADDRESS",
+		"ip_addr":                       "INVALID_IP_ADDRESS",
+		"ip4_addr":                      "INVALID_IP_ADDRESS",
+		"ip6_addr":                      "INVALID_IP_ADDRESS",
+		"unix_addr":                     "INVALID_NETWORK_ADDRESS",
+		"mac":                           "INVALID_MAC_ADDRESS",
+		"hostname":                      "INVALID_HOSTNAME",
+		"hostname_rfc1123":              "INVALID_HOSTNAME",
+		"hostname_port":                 "INVALID_HOSTNAME",
+		"fqdn":                          "INVALID_HOSTNAME",
+		"unique":                        "DUPLICATE_VALUES",
+		"html":                          "INVALID_HTML",
+		"html_encoded":                  "INVALID_ENCODING",
+		"url_encoded":                   "INVALID_ENCODING",
+		"json":                          "INVALID_JSON",
+		"jwt":                           "INVALID_JWT",
+		"lowercase":                     "INVALID_CASE",
+		"uppercase":                     "INVALID_CASE",
+		"datetime":                      "INVALID_DATETIME",
+		"timezone":                      "INVALID_TIMEZONE",
+		"iso3166_1_alpha2":              "INVALID_COUNTRY_CODE",
+		"iso3166_1_alpha3":              "INVALID_COUNTRY_CODE",
+		"iso3166_1_alpha_numeric":       "INVALID_COUNTRY_CODE",
+		"country_code":                  "INVALID_COUNTRY_CODE",
+		"iso3166_2":                     "INVALID_SUBDIVISION_CODE",
+		"iso4217":                       "INVALID_CURRENCY_CODE",
+		"iso4217_numeric":               "INVALID_CURRENCY_CODE",
+		"bcp47_language_tag":            "INVALID_LANGUAGE_TAG",
+		"postcode_iso3166_alpha2":       "INVALID_POSTCODE",
+		"postcode_iso3166_alpha2_field": "INVALID_POSTCODE",
+		"bic":                           "INVALID_BIC",
+		"semver":                        "INVALID_SEMVER",
+		"dns_rfc1035_label":             "INVALID_DNS_LABEL",
+		"credit_card":                   "INVALID_CREDIT_CARD",
+		"cve":                           "INVALID_CVE",
+		"luhn_checksum":                 "INVALID_CHECKSUM",
+		"mongodb":                       "INVALID_MONGODB_ID",
+		"cron":                          "INVALID_CRON",
+		"spicedb":                       "INVALID_SPICEDB_ID",
+		TagCategory:                     "INVALID_CATEGORY",
+		TagMinCategory:                  "INVALID_CATEGORY_LIST",
+		TagGender:                       "INVALID_GENDER",
+		TagInSet:                        "VALUE_NOT_ALLOWED",
+		TagType:                         "INVALID_TYPE",
+		TagColumnCount:                  "INVALID_COLUMN_COUNT",
+		AliasAppEmail:                   "INVALID_EMAIL",
+	}
+)
+
+// Code untuk mengambil error code dari satu error field
+// dicari berdasarkan tag (alias) lalu actual tag, jika tidak ada dikembalikan CodeInvalid
+func Code(fieldError validator.FieldError) string {
+	for _, tag := range []string{fieldError.Tag(), fieldError.ActualTag()} {
+		if code, ok := TagCode(tag, fieldError.Kind()); ok {
+			return code
+		}
+	}
+
+	return CodeInvalid
+}
+
+// TagCode untuk mengambil error code dari tag sesuai kind field
+func TagCode(tag string, kind reflect.Kind) (string, bool) {
+	codesMutex.RLock()
+	defer codesMutex.RUnlock()
+
+	if suffix := kindSuffix(kind); suffix != "" {
+		if code, ok := codes[tag+suffix]; ok {
+			return code, true
+		}
+	}
+
+	code, ok := codes[tag]
+	return code, ok
+}
+
+// RegisterCode untuk mendaftarkan error code dari custom tag
+// code yang sudah terdaftar tidak bisa diganti agar tetap stabil untuk client
+// contoh : validation.RegisterCode("even", "VALUE_NOT_EVEN")
+func RegisterCode(tag, code string) error {
+	if tag == "" || code == "" {
+		return fmt.Errorf("tag and code cannot be empty")
+	}
+
+	codesMutex.Lock()
+	defer codesMutex.Unlock()
+
+	if existing, ok := codes[tag]; ok && existing != code {
+		return fmt.Errorf("tag %q already registered with code %q", tag, existing)
+	}
+
+	codes[tag] = code
+	return nil
+}
+
+// Codes untuk mengambil salinan semua error code yang terdaftar
+func Codes() map[string]string {
+	codesMutex.RLock()
+	defer codesMutex.RUnlock()
+
+	return maps.Clone(codes)
+}
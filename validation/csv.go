@@ -0,0 +1,410 @@
+package validation
+
+import (
+	"context"
+	"encoding"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// DefaultCSVTag adalah nama struct tag yang berisi nama kolom CSV
+const DefaultCSVTag = "csv"
+
+// TagType adalah tag di CSVIssue saat isi kolom tidak bisa diubah ke type field
+const TagType = "type"
+
+// TagColumnCount adalah tag di CSVIssue saat jumlah kolom di satu baris berbeda dengan header
+// issue ini tidak punya Column karena berlaku untuk seluruh baris
+const TagColumnCount = "column_count"
+
+// ErrMissingColumn adalah error jika kolom dari struct tag tidak ada di header CSV
+var ErrMissingColumn = errors.New("missing column")
+
+// CSVIssue adalah satu error validasi dari satu kolom di satu baris CSV
+// Code adalah error code yang stabil dari Code, sama seperti di FieldError
+type CSVIssue struct {
+	Row     int    `json:"row"`
+	Column  string `json:"column"`
+	Tag     string `json:"tag"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// CSVRow adalah hasil validasi satu baris CSV
+type CSVRow[T any] struct {
+	// Row adalah nomor baris di file, header adalah baris 1
+	Row int
+
+	// Value adalah isi baris yang sudah diubah ke type T
+	Value T
+
+	// Issues berisi error konversi type dan error validasi, kosong jika baris valid
+	Issues []CSVIssue
+}
+
+// CSVValidator untuk validasi file CSV baris per baris tanpa membaca seluruh file ke memory
+// kolom di header dipetakan ke field dengan struct tag csv, field tanpa tag csv tidak diisi
+// isi kolom diubah sesuai type field (string, bool, int, uint, float, time.Duration, pointer
+// atau type yang mengimplementasikan encoding.TextUnmarshaler), kolom kosong berarti zero value
+// lalu setiap baris divalidasi dengan tag validate
+// contoh : validation.NewCSVValidator[Customer](validate).Report(ctx, file, validation.NewReportWriter(os.Stdout, validation.ReportText))
+type CSVValidator[T any] struct {
+	// Validate adalah validator untuk setiap baris
+	Validate *validator.Validate
+
+	// Catalog dan Locale dipakai untuk membuat message di CSVIssue
+	Catalog *Catalog
+	Locale  string
+
+	// TagName adalah nama struct tag untuk nama kolom, default DefaultCSVTag
+	TagName string
+
+	// Comma adalah pemisah kolom, default koma
+	Comma rune
+
+	// MaxErrors adalah jumlah baris tidak valid maksimal, setelah itu validasi berhenti, 0 berarti tanpa batas
+	MaxErrors int
+
+	// SkipValid true jika hanya baris tidak valid yang dikirim ke callback
+	SkipValid bool
+}
+
+// NewCSVValidator untuk membuat CSVValidator dengan catalog bawaan dan locale en
+func NewCSVValidator[T any](validate *validator.Validate) *CSVValidator[T] {
+	return &CSVValidator[T]{
+		Validate: validate,
+		Catalog:  NewCatalog(),
+		Locale:   LocaleEnglish,
+		TagName:  DefaultCSVTag,
+		Comma:    ',',
+	}
+}
+
+// csvColumn adalah field struct yang diisi dari satu kolom CSV
+type csvColumn struct {
+	name     string
+	field    string
+	index    []int
+	position int
+}
+
+// Run untuk membaca dan validasi setiap baris lalu memanggil fn dengan hasilnya
+// error dari fn menghentikan validasi dan dikembalikan apa adanya
+// baris dengan jumlah kolom berbeda dari header tidak divalidasi, hanya berisi satu issue TagColumnCount
+// CSV yang rusak, kolom yang tidak ada di header dan context yang dibatalkan menghentikan validasi
+func (c *CSVValidator[T]) Run(ctx context.Context, reader io.Reader, fn func(row CSVRow[T]) error) (StreamSummary, error) {
+	var summary StreamSummary
+
+	columns, err := csvColumns(reflect.TypeFor[T](), c.TagName)
+	if err != nil {
+		return summary, err
+	}
+
+	csvReader := csv.NewReader(reader)
+	csvReader.Comma = c.Comma
+	csvReader.ReuseRecord = true
+	csvReader.FieldsPerRecord = -1
+
+	header, err := csvReader.Read()
+	if errors.Is(err, io.EOF) {
+		return summary, nil
+	}
+	if err != nil {
+		return summary, err
+	}
+
+	if err := bindColumns(columns, header); err != nil {
+		return summary, err
+	}
+
+	// isi header ikut berubah karena ReuseRecord, jadi hanya jumlah kolomnya yang disimpan
+	columnCount := len(header)
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return summary, err
+		}
+
+		record, err := csvReader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return summary, err
+		}
+
+		line, _ := csvReader.FieldPos(0)
+
+		var row CSVRow[T]
+		if len(record) != columnCount {
+			fieldError := NewFieldError("", "", TagColumnCount, TagColumnCount, strconv.Itoa(columnCount), len(record))
+			row = CSVRow[T]{Row: line, Issues: []CSVIssue{c.issue(line, "", fieldError)}}
+		} else if row, err = c.validateRow(ctx, line, record, columns); err != nil {
+			return summary, err
+		}
+
+		summary.Records++
+		if len(row.Issues) > 0 {
+			summary.Invalid++
+		}
+
+		if len(row.Issues) > 0 || !c.SkipValid {
+			if err := fn(row); err != nil {
+				return summary, err
+			}
+		}
+
+		if c.MaxErrors > 0 && summary.Invalid >= c.MaxErrors {
+			_, err := csvReader.Read()
+			summary.Truncated = !errors.Is(err, io.EOF)
+			return summary, nil
+		}
+	}
+
+	return summary, nil
+}
+
+// Report untuk menulis semua CSVIssue ke report lalu menutup report, baris valid tidak menulis apapun
+// report tetap ditutup saat validasi berhenti karena error supaya isi report tetap lengkap
+func (c *CSVValidator[T]) Report(ctx context.Context, reader io.Reader, report ReportWriter) (StreamSummary, error) {
+	summary, err := c.Run(ctx, reader, func(row CSVRow[T]) error {
+		for _, issue := range row.Issues {
+			if err := report.WriteIssue(issue); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+
+	return summary, errors.Join(err, report.Close())
+}
+
+// validateRow untuk mengisi value dari satu baris lalu validasi
+// field yang gagal dikonversi tidak divalidasi supaya tidak ada dua error untuk kolom yang sama
+func (c *CSVValidator[T]) validateRow(ctx context.Context, line int, record []string, columns []csvColumn) (CSVRow[T], error) {
+	row := CSVRow[T]{Row: line}
+	value := reflect.ValueOf(&row.Value).Elem()
+
+	failed := map[string]bool{}
+	for _, column := range columns {
+		text := record[column.position]
+		if err := setCSVValue(value.FieldByIndex(column.index), text); err != nil {
+			fieldError := NewFieldError(column.name, column.name, TagType, TagType, csvTypeName(value.FieldByIndex(column.index).Type()), text)
+			row.Issues = append(row.Issues, c.issue(line, column.name, fieldError))
+			failed[column.field] = true
+		}
+	}
+
+	err := CheckStruct(c.Validate, &row.Value)
+	if err == nil {
+		err = c.Validate.StructCtx(ctx, &row.Value)
+	}
+
+	var validationErrors validator.ValidationErrors
+	if err != nil && !errors.As(err, &validationErrors) {
+		return row, err
+	}
+
+	for _, fieldError := range validationErrors {
+		field := topField(fieldError)
+		if failed[field] {
+			continue
+		}
+
+		column := Path(fieldError)
+		for _, candidate := range columns {
+			if candidate.field == field {
+				column = candidate.name
+				break
+			}
+		}
+
+		row.Issues = append(row.Issues, c.issue(line, column, fieldError))
+	}
+
+	return row, nil
+}
+
+// issue untuk membuat CSVIssue dengan message dari catalog
+func (c *CSVValidator[T]) issue(line int, column string, fieldError validator.FieldError) CSVIssue {
+	return CSVIssue{
+		Row:     line,
+		Column:  column,
+		Tag:     fieldError.Tag(),
+		Code:    Code(fieldError),
+		Message: c.Catalog.Translate(fieldError, c.Locale),
+	}
+}
+
+// csvColumns untuk mengambil field struct yang punya struct tag kolom CSV, hanya field langsung dari struct
+func csvColumns(typ reflect.Type, tagName string) ([]csvColumn, error) {
+	if typ.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("csv: %s is not a struct", typ)
+	}
+
+	var columns []csvColumn
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get(tagName), ",")
+		if name == "" || name == "-" || !field.IsExported() {
+			continue
+		}
+
+		if !csvSupported(field.Type) {
+			return nil, fmt.Errorf("csv: column %q: unsupported type %s", name, field.Type)
+		}
+
+		columns = append(columns, csvColumn{name: name, field: field.Name, index: field.Index})
+	}
+
+	return columns, nil
+}
+
+// bindColumns untuk mencari posisi setiap kolom di header, spasi dan BOM di awal file diabaikan
+func bindColumns(columns []csvColumn, header []string) error {
+	positions := make(map[string]int, len(header))
+	for i, name := range header {
+		if i == 0 {
+			name = strings.TrimPrefix(name, "\ufeff")
+		}
+		positions[strings.TrimSpace(name)] = i
+	}
+
+	for i := range columns {
+		position, ok := positions[columns[i].name]
+		if !ok {
+			return fmt.Errorf("csv: %w %q", ErrMissingColumn, columns[i].name)
+		}
+		columns[i].position = position
+	}
+
+	return nil
+}
+
+var (
+	durationType        = reflect.TypeFor[time.Duration]()
+	textUnmarshalerType = reflect.TypeFor[encoding.TextUnmarshaler]()
+)
+
+// csvSupported untuk mengecek type field bisa diisi dari teks kolom CSV
+func csvSupported(typ reflect.Type) bool {
+	if typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+
+	if reflect.PointerTo(typ).Implements(textUnmarshalerType) {
+		return true
+	}
+
+	switch typ.Kind() {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	default:
+		return false
+	}
+}
+
+// setCSVValue untuk mengubah teks kolom ke type field, teks kosong membuat field tetap zero value
+func setCSVValue(field reflect.Value, text string) error {
+	if text == "" {
+		return nil
+	}
+
+	if field.Kind() == reflect.Pointer {
+		value := reflect.New(field.Type().Elem())
+		if err := setCSVValue(value.Elem(), text); err != nil {
+			return err
+		}
+
+		field.Set(value)
+		return nil
+	}
+
+	if unmarshaler, ok := field.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		return unmarshaler.UnmarshalText([]byte(text))
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(text)
+	case reflect.Bool:
+		value, err := strconv.ParseBool(text)
+		if err != nil {
+			return err
+		}
+		field.SetBool(value)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if field.Type() == durationType {
+			value, err := time.ParseDuration(text)
+			if err != nil {
+				return err
+			}
+			field.SetInt(int64(value))
+			return nil
+		}
+
+		value, err := strconv.ParseInt(text, 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetInt(value)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		value, err := strconv.ParseUint(text, 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetUint(value)
+	case reflect.Float32, reflect.Float64:
+		value, err := strconv.ParseFloat(text, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetFloat(value)
+	}
+
+	return nil
+}
+
+// csvTypeName untuk nama type di message error konversi, misal int, bool atau time.Time
+func csvTypeName(typ reflect.Type) string {
+	if typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+
+	if typ == durationType {
+		return "duration"
+	}
+
+	switch typ.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "integer"
+	case reflect.Float32, reflect.Float64:
+		return "number"
+	case reflect.Bool:
+		return "boolean"
+	default:
+		return typ.String()
+	}
+}
+
+// topField untuk mengambil nama field paling luar dari error, contoh : "Customer.Alamat[0].Kota" menjadi "Alamat"
+func topField(fieldError validator.FieldError) string {
+	_, path, _ := strings.Cut(fieldError.StructNamespace(), ".")
+	if end := strings.IndexAny(path, ".["); end >= 0 {
+		path = path[:end]
+	}
+
+	return path
+}
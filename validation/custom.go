@@ -0,0 +1,52 @@
+package validation
+
+import (
+	"github.com/go-playground/validator/v10"
+)
+
+const (
+	TagCategory    = "category"
+	TagMinCategory = "min_category"
+	TagGender      = "gender"
+
+	AliasAppEmail = "app_email"
+	AppEmailTags  = "required,email,min=15"
+)
+
+var (
+	// Categories adalah daftar value awal dari set category, dipakai oleh tag category
+	Categories = []string{"hobby", "gadget", "adventure", "automotive"}
+
+	// CategoryOptions adalah daftar value yang valid untuk setiap item di tag min_category
+	CategoryOptions = []string{"a", "b", "c", "d", "e"}
+
+	// Genders adalah daftar value awal dari set gender, dipakai oleh tag gender
+	Genders = []string{"male", "female"}
+)
+
+// ValidateCategory untuk validasi value string harus ada di set category
+// sama seperti `validate:"inset=category"`
+// contoh : `validate:"category"`
+func ValidateCategory(field validator.FieldLevel) bool {
+	return inSet(SetCategory, field)
+}
+
+// ValidateMinCategory untuk validasi []string dengan jumlah item minimal sesuai parameter
+// dan setiap item harus ada di CategoryOptions
+// parameter sudah diubah ke int oleh WithParamValidation menggunakan IntParam
+// contoh : `validate:"min_category=2"`
+func ValidateMinCategory(field validator.FieldLevel, length int) bool {
+	value, ok := field.Field().Interface().([]string)
+	if !ok {
+		return false
+	}
+
+	return HasMinCategory(value, length)
+}
+
+// ValidateGender untuk validasi value string harus ada di set gender
+// sama seperti `validate:"inset=gender"`
+// contoh : `validate:"gender"`
+func ValidateGender(field validator.FieldLevel) bool {
+	return inSet(SetGender, field)
+}
@@ -0,0 +1,41 @@
+package validation
+
+import (
+	"errors"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// FieldError adalah bentuk error per field yang siap dikirim ke client
+type FieldError struct {
+	Field      string `json:"field"`
+	Tag        string `json:"tag"`
+	Code       string `json:"code"`
+	Param      string `json:"param,omitempty"`
+	Message    string `json:"message"`
+	Suggestion string `json:"suggestion,omitempty"`
+}
+
+// FieldErrors untuk mengubah validator.ValidationErrors menjadi list FieldError
+// Field berisi Path dari error, message dibuat dari catalog sesuai locale
+// error selain validator.ValidationErrors akan menghasilkan nil
+func FieldErrors(err error, catalog *Catalog, locale string) []FieldError {
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		return nil
+	}
+
+	fieldErrors := make([]FieldError, 0, len(validationErrors))
+	for _, fieldError := range validationErrors {
+		fieldErrors = append(fieldErrors, FieldError{
+			Field:      Path(fieldError),
+			Tag:        fieldError.Tag(),
+			Code:       Code(fieldError),
+			Param:      fieldError.Param(),
+			Message:    catalog.Translate(fieldError, locale),
+			Suggestion: catalog.Suggestion(fieldError),
+		})
+	}
+
+	return fieldErrors
+}
@@ -0,0 +1,56 @@
+package validation
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// WithFieldNameTag untuk memakai nama dari struct tag (misal json) sebagai nama field di error
+// tag dicek sesuai urutan, jika tidak ada atau bernilai "-" dicek tag berikutnya
+// jika semua tag kosong maka tetap memakai nama field Go
+// contoh : validation.New(validation.WithFieldNameTag("json", "form", "yaml", "query"))
+func WithFieldNameTag(tags ...string) Option {
+	if len(tags) == 0 {
+		tags = []string{"json"}
+	}
+
+	return func(validate *validator.Validate) error {
+		validate.RegisterTagNameFunc(func(field reflect.StructField) string {
+			return fieldName(field, tags)
+		})
+		return nil
+	}
+}
+
+// fieldName untuk mengambil nama field dari tag pertama yang punya nama
+// option setelah koma seperti omitempty diabaikan
+func fieldName(field reflect.StructField, tags []string) string {
+	for _, tag := range tags {
+		name, _, _ := strings.Cut(field.Tag.Get(tag), ",")
+		if name == "" || name == "-" {
+			continue
+		}
+
+		return name
+	}
+
+	return ""
+}
+
+// Path untuk mengambil namespace error tanpa nama struct paling luar
+// contoh : "User.addresses[0].city" menjadi "addresses[0].city"
+// untuk validasi variabel (VarCtx) namespace dikembalikan apa adanya, misal "[user1]"
+func Path(fieldError validator.FieldError) string {
+	namespace := fieldError.Namespace()
+	if strings.HasPrefix(namespace, "[") {
+		return namespace
+	}
+
+	if _, path, ok := strings.Cut(namespace, "."); ok {
+		return path
+	}
+
+	return namespace
+}
@@ -0,0 +1,132 @@
+package validation
+
+import (
+	"fmt"
+	"net"
+	"reflect"
+	"regexp"
+	"slices"
+
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+)
+
+// regex yang sama dengan tag bawaan validator, dipakai oleh kode hasil validategen
+// hasilnya dibandingkan dengan validator oleh TestGeneratedHelpers supaya tetap sama saat validator diupgrade
+var (
+	alphaRegex    = regexp.MustCompile("^[a-zA-Z]+$")
+	alphanumRegex = regexp.MustCompile("^[a-zA-Z0-9]+$")
+	numericRegex  = regexp.MustCompile("^[-+]?[0-9]+(?:\\.[0-9]+)?$")
+	emailRegex    = regexp.MustCompile("^(?:(?:(?:(?:[a-zA-Z]|\\d|[!#\\$%&'\\*\\+\\-\\/=\\?\\^_`{\\|}~]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])+(?:\\.([a-zA-Z]|\\d|[!#\\$%&'\\*\\+\\-\\/=\\?\\^_`{\\|}~]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])+)*)|(?:(?:\\x22)(?:(?:(?:(?:\\x20|\\x09)*(?:\\x0d\\x0a))?(?:\\x20|\\x09)+)?(?:(?:[\\x01-\\x08\\x0b\\x0c\\x0e-\\x1f\\x7f]|\\x21|[\\x23-\\x5b]|[\\x5d-\\x7e]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])|(?:(?:[\\x01-\\x09\\x0b\\x0c\\x0d-\\x7f]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}]))))*(?:(?:(?:\\x20|\\x09)*(?:\\x0d\\x0a))?(\\x20|\\x09)+)?(?:\\x22))))@(?:(?:(?:[a-zA-Z]|\\d|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])|(?:(?:[a-zA-Z]|\\d|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])(?:[a-zA-Z]|\\d|-|\\.|~|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])*(?:[a-zA-Z]|\\d|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])))\\.)+(?:(?:[a-zA-Z]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])|(?:(?:[a-zA-Z]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])(?:[a-zA-Z]|\\d|-|\\.|~|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])*(?:[a-zA-Z]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])))\\.?$")
+)
+
+// IsEmail sama seperti tag email
+func IsEmail(value string) bool {
+	return emailRegex.MatchString(value)
+}
+
+// IsAlpha sama seperti tag alpha
+func IsAlpha(value string) bool {
+	return alphaRegex.MatchString(value)
+}
+
+// IsAlphanum sama seperti tag alphanum
+func IsAlphanum(value string) bool {
+	return alphanumRegex.MatchString(value)
+}
+
+// IsNumeric sama seperti tag numeric untuk value string
+func IsNumeric(value string) bool {
+	return numericRegex.MatchString(value)
+}
+
+// IsIP sama seperti tag ip
+func IsIP(value string) bool {
+	return net.ParseIP(value) != nil
+}
+
+// IsIPv4 sama seperti tag ipv4
+func IsIPv4(value string) bool {
+	ip := net.ParseIP(value)
+	return ip != nil && ip.To4() != nil
+}
+
+// IsIPv6 sama seperti tag ipv6
+func IsIPv6(value string) bool {
+	ip := net.ParseIP(value)
+	return ip != nil && ip.To4() == nil
+}
+
+// InSet sama seperti tag inset=name, juga dipakai untuk tag category dan gender
+func InSet(name, value string) bool {
+	set, ok := LookupSet(name)
+	return ok && set.Contains(value)
+}
+
+// HasMinCategory sama seperti tag min_category=length
+func HasMinCategory(values []string, length int) bool {
+	for _, value := range values {
+		if !slices.Contains(CategoryOptions, value) {
+			return false
+		}
+	}
+
+	return len(values) >= length
+}
+
+// generatedFieldError adalah validator.FieldError yang dibuat oleh kode hasil validategen tanpa reflection
+// Kind dan Type baru dihitung dari value saat dipanggil
+type generatedFieldError struct {
+	namespace string
+	field     string
+	tag       string
+	actualTag string
+	param     string
+	value     any
+}
+
+// NewFieldError untuk membuat validator.FieldError dari kode hasil validategen
+// namespace dan field memakai nama field struct, sama seperti validator tanpa RegisterTagNameFunc
+// contoh : validation.NewFieldError("User.Addresses[0].City", "City", "required", "required", "", value)
+func NewFieldError(namespace, field, tag, actualTag, param string, value any) validator.FieldError {
+	return &generatedFieldError{
+		namespace: namespace,
+		field:     field,
+		tag:       tag,
+		actualTag: actualTag,
+		param:     param,
+		value:     value,
+	}
+}
+
+func (e *generatedFieldError) Tag() string             { return e.tag }
+func (e *generatedFieldError) ActualTag() string       { return e.actualTag }
+func (e *generatedFieldError) Namespace() string       { return e.namespace }
+func (e *generatedFieldError) StructNamespace() string { return e.namespace }
+func (e *generatedFieldError) Field() string           { return e.field }
+func (e *generatedFieldError) StructField() string     { return e.field }
+func (e *generatedFieldError) Value() any              { return e.value }
+func (e *generatedFieldError) Param() string           { return e.param }
+
+// Kind untuk mengambil kind dari value, pointer nil tetap reflect.Pointer
+func (e *generatedFieldError) Kind() reflect.Kind {
+	if e.value == nil {
+		return reflect.Invalid
+	}
+	return reflect.TypeOf(e.value).Kind()
+}
+
+// Type untuk mengambil type dari value
+func (e *generatedFieldError) Type() reflect.Type {
+	return reflect.TypeOf(e.value)
+}
+
+// Translate tidak memakai translator dari validator, gunakan Catalog untuk message
+func (e *generatedFieldError) Translate(ut.Translator) string {
+	return e.Error()
+}
+
+// Error sama dengan format error dari validator
+func (e *generatedFieldError) Error() string {
+	return fmt.Sprintf("Key: '%s' Error:Field validation for '%s' failed on the '%s' tag", e.namespace, e.field, e.tag)
+}
@@ -0,0 +1,264 @@
+package validation
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// DefaultMaxBodySize adalah batas ukuran body request (1 MB)
+const DefaultMaxBodySize int64 = 1 << 20
+
+// ErrInvalidBody dikembalikan DecodeJSON jika body request bukan JSON yang valid
+// termasuk jika ada field yang tidak dikenal atau ada data setelah object JSON
+var ErrInvalidBody = errors.New("invalid request body")
+
+// ErrorResponse adalah body response saat request gagal di decode atau divalidasi
+type ErrorResponse struct {
+	Message string       `json:"message"`
+	Errors  []FieldError `json:"errors,omitempty"`
+
+	// Omitted adalah jumlah error yang tidak ditulis karena WithStructOptions
+	Omitted int `json:"omitted,omitempty"`
+}
+
+// HTTPOption untuk mengatur DecodeJSON, Handler dan Middleware
+type HTTPOption func(config *httpConfig)
+
+// ErrorHandler untuk menulis response saat request gagal di decode atau divalidasi
+type ErrorHandler func(w http.ResponseWriter, r *http.Request, err error)
+
+type httpConfig struct {
+	catalog            *Catalog
+	maxBodySize        int64
+	allowUnknownFields bool
+	problemDetails     bool
+	errorHandler       ErrorHandler
+	structOptions      []StructOption
+}
+
+// WithCatalog untuk mengganti catalog yang dipakai membuat message error
+func WithCatalog(catalog *Catalog) HTTPOption {
+	return func(config *httpConfig) {
+		config.catalog = catalog
+	}
+}
+
+// WithMaxBodySize untuk mengganti batas ukuran body request dalam byte
+func WithMaxBodySize(size int64) HTTPOption {
+	return func(config *httpConfig) {
+		config.maxBodySize = size
+	}
+}
+
+// WithUnknownFields untuk mengizinkan field JSON yang tidak ada di struct
+// secara default field yang tidak dikenal akan ditolak
+func WithUnknownFields() HTTPOption {
+	return func(config *httpConfig) {
+		config.allowUnknownFields = true
+	}
+}
+
+// WithProblemDetails untuk menulis response error dalam format application/problem+json (RFC 7807)
+func WithProblemDetails() HTTPOption {
+	return func(config *httpConfig) {
+		config.problemDetails = true
+	}
+}
+
+// WithErrorHandler untuk mengganti cara menulis response error
+func WithErrorHandler(handler ErrorHandler) HTTPOption {
+	return func(config *httpConfig) {
+		config.errorHandler = handler
+	}
+}
+
+// WithStructOptions untuk mengatur validasi body request, misal batas jumlah error
+// contoh : validation.Handler(validate, handler, validation.WithStructOptions(validation.WithMaxErrors(50)))
+func WithStructOptions(options ...StructOption) HTTPOption {
+	return func(config *httpConfig) {
+		config.structOptions = append(config.structOptions, options...)
+	}
+}
+
+func newHTTPConfig(options []HTTPOption) *httpConfig {
+	config := &httpConfig{
+		catalog:     NewCatalog(),
+		maxBodySize: DefaultMaxBodySize,
+	}
+
+	for _, option := range options {
+		option(config)
+	}
+
+	switch {
+	case config.errorHandler != nil:
+	case config.problemDetails:
+		config.errorHandler = problemErrorHandler(config.catalog)
+	default:
+		config.errorHandler = jsonErrorHandler(config.catalog)
+	}
+
+	return config
+}
+
+// DecodeJSON untuk decode body request ke T lalu validasi menggunakan StructCtx dengan context dari request
+// T harus berupa struct atau pointer ke struct
+// contoh : request, err := validation.DecodeJSON[LoginRequest](r, validate)
+func DecodeJSON[T any](r *http.Request, validate *validator.Validate, options ...HTTPOption) (T, error) {
+	return decodeJSON[T](r, validate, newHTTPConfig(options))
+}
+
+func decodeJSON[T any](r *http.Request, validate *validator.Validate, config *httpConfig) (T, error) {
+	var value T
+
+	decoder := json.NewDecoder(http.MaxBytesReader(nil, r.Body, config.maxBodySize))
+
+	var body json.RawMessage
+	if err := decoder.Decode(&body); err != nil {
+		return value, decodeError(err)
+	}
+
+	// body hanya boleh berisi satu object JSON
+	if err := decoder.Decode(&struct{}{}); !errors.Is(err, io.EOF) {
+		if err == nil {
+			err = errors.New("body must only contain a single JSON value")
+		}
+		return value, decodeError(err)
+	}
+
+	// null tidak menghasilkan error decode tetapi tidak mengisi struct, pointer tetap nil
+	// selain object dan null, misal array, sudah ditolak oleh decode ke T
+	if string(body) == "null" {
+		return value, decodeError(errors.New("body must be a JSON object"))
+	}
+
+	bodyDecoder := json.NewDecoder(bytes.NewReader(body))
+	if !config.allowUnknownFields {
+		bodyDecoder.DisallowUnknownFields()
+	}
+
+	if err := bodyDecoder.Decode(&value); err != nil {
+		return value, decodeError(err)
+	}
+
+	if err := StructCtx(r.Context(), validate, value, config.structOptions...); err != nil {
+		return value, err
+	}
+
+	return value, nil
+}
+
+// decodeError untuk membungkus error decode dengan ErrInvalidBody
+// kecuali error karena body melebihi batas ukuran
+func decodeError(err error) error {
+	var maxBytesError *http.MaxBytesError
+	if errors.As(err, &maxBytesError) {
+		return err
+	}
+
+	return fmt.Errorf("%w: %w", ErrInvalidBody, err)
+}
+
+// Handler untuk membuat http.Handler yang menerima value T yang sudah di decode dan valid
+// jika gagal, response ditulis oleh error handler dan handler tidak dipanggil
+// contoh : http.Handle("/login", validation.Handler(validate, func(w http.ResponseWriter, r *http.Request, request LoginRequest) {...}))
+func Handler[T any](validate *validator.Validate, handler func(w http.ResponseWriter, r *http.Request, value T), options ...HTTPOption) http.Handler {
+	config := newHTTPConfig(options)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		value, err := decodeJSON[T](r, validate, config)
+		if err != nil {
+			config.errorHandler(w, r.WithContext(context.WithValue(r.Context(), problemValueKey{}, value)), err)
+			return
+		}
+
+		handler(w, r, value)
+	})
+}
+
+type contextKey[T any] struct{}
+
+// Middleware untuk decode dan validasi body request sebelum diteruskan ke handler berikutnya
+// value yang sudah valid diambil menggunakan ValueFromContext
+func Middleware[T any](validate *validator.Validate, options ...HTTPOption) func(next http.Handler) http.Handler {
+	config := newHTTPConfig(options)
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			value, err := decodeJSON[T](r, validate, config)
+			if err != nil {
+				config.errorHandler(w, r.WithContext(context.WithValue(r.Context(), problemValueKey{}, value)), err)
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), contextKey[T]{}, value)))
+		})
+	}
+}
+
+// ValueFromContext untuk mengambil value yang disimpan oleh Middleware
+func ValueFromContext[T any](ctx context.Context) (T, bool) {
+	value, ok := ctx.Value(contextKey[T]{}).(T)
+	return value, ok
+}
+
+// jsonErrorHandler adalah error handler bawaan yang menulis ErrorResponse dalam JSON
+// message validasi dibuat sesuai header Accept-Language
+func jsonErrorHandler(catalog *Catalog) ErrorHandler {
+	return func(w http.ResponseWriter, r *http.Request, err error) {
+		status, message := errorStatus(err)
+		response := ErrorResponse{
+			Message: message,
+			Errors:  FieldErrors(err, catalog, catalog.requestLocale(r)),
+			Omitted: Omitted(err),
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_ = json.NewEncoder(w).Encode(response)
+	}
+}
+
+// errorStatus untuk menentukan status HTTP dan message dari error DecodeJSON
+// status 422 untuk error validasi, 413 jika body terlalu besar dan 400 jika body tidak valid
+func errorStatus(err error) (int, string) {
+	var maxBytesError *http.MaxBytesError
+	var truncatedErrors *TruncatedErrors
+	var validationErrors validator.ValidationErrors
+
+	switch {
+	case errors.As(err, &truncatedErrors):
+		return http.StatusUnprocessableEntity, fmt.Sprintf("validation failed, showing %d errors and %s more", len(truncatedErrors.Errors), truncatedErrors.more())
+	case errors.As(err, &validationErrors):
+		return http.StatusUnprocessableEntity, "validation failed"
+	case errors.As(err, &maxBytesError):
+		return http.StatusRequestEntityTooLarge, fmt.Sprintf("request body must not be larger than %d bytes", maxBytesError.Limit)
+	case errors.Is(err, ErrInvalidBody):
+		return http.StatusBadRequest, err.Error()
+	default:
+		return http.StatusInternalServerError, http.StatusText(http.StatusInternalServerError)
+	}
+}
+
+// requestLocale untuk mengambil locale pertama dari header Accept-Language yang didukung catalog
+// contoh : "fr-FR, id-ID;q=0.8" menghasilkan "id"
+func (c *Catalog) requestLocale(r *http.Request) string {
+	for _, language := range strings.Split(r.Header.Get("Accept-Language"), ",") {
+		language, _, _ = strings.Cut(language, ";")
+		language = baseLocale(language)
+
+		if _, ok := c.messages[language]; ok {
+			return language
+		}
+	}
+
+	return LocaleEnglish
+}
@@ -0,0 +1,302 @@
+package validation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// StructOption untuk mengatur satu kali pemanggilan StructCtx
+type StructOption func(config *structConfig)
+
+type structConfig struct {
+	maxErrors        int
+	maxErrorsPerPath int
+}
+
+// WithFailFast untuk hanya mengembalikan error pertama
+// sama seperti WithMaxErrors(1)
+func WithFailFast() StructOption {
+	return WithMaxErrors(1)
+}
+
+// WithMaxErrors untuk membatasi jumlah error yang dikembalikan, 0 berarti tanpa batas
+func WithMaxErrors(max int) StructOption {
+	return func(config *structConfig) {
+		config.maxErrors = max
+	}
+}
+
+// WithMaxErrorsPerPath untuk membatasi jumlah error dari path yang sama, 0 berarti tanpa batas
+// index slice, array dan key map diabaikan, misal "Addresses[0].City" dan "Addresses[7].City"
+// dihitung sebagai path yang sama yaitu "Addresses[].City"
+func WithMaxErrorsPerPath(max int) StructOption {
+	return func(config *structConfig) {
+		config.maxErrorsPerPath = max
+	}
+}
+
+// TruncatedErrors adalah error validasi yang dipotong karena WithMaxErrors atau WithMaxErrorsPerPath
+// errors.As(err, &validator.ValidationErrors{}) tetap bisa dipakai untuk mengambil error yang tersisa
+type TruncatedErrors struct {
+	// Errors berisi error yang dikembalikan sesuai urutan dari validator
+	Errors validator.ValidationErrors
+
+	// Omitted adalah jumlah error yang ditemukan lalu dibuang
+	Omitted int
+
+	// Stopped true jika validasi berhenti sebelum semua field diperiksa karena WithMaxErrors
+	// jumlah error sebenarnya tidak diketahui, Omitted hanya jumlah minimal
+	Stopped bool
+}
+
+// Error untuk message semua error yang tersisa ditambah jumlah error yang dibuang
+// contoh : "... and 950 more" atau "... and at least 1 more" jika validasi berhenti lebih awal
+func (e *TruncatedErrors) Error() string {
+	return fmt.Sprintf("%s\nand %s more", e.Errors.Error(), e.more())
+}
+
+// more untuk jumlah error yang dibuang, diawali "at least" jika jumlah sebenarnya tidak diketahui
+func (e *TruncatedErrors) more() string {
+	if e.Stopped {
+		return fmt.Sprintf("at least %d", e.Omitted)
+	}
+
+	return strconv.Itoa(e.Omitted)
+}
+
+// Unwrap untuk mengembalikan validator.ValidationErrors yang tersisa
+func (e *TruncatedErrors) Unwrap() error {
+	return e.Errors
+}
+
+// StructCtx untuk validasi struct sama seperti validate.StructCtx dengan batas jumlah error
+// dengan WithMaxErrors field paling luar divalidasi satu per satu sesuai urutan deklarasi, item dari field
+// slice atau array dengan tag dive juga satu per satu, lalu validasi berhenti begitu ada error melebihi batas
+// tanpa WithMaxErrors semua field tetap diperiksa karena batas per path hanya bisa dihitung dari semua error
+// jika ada error yang dibuang, error dikembalikan sebagai *TruncatedErrors
+//
+// item dive divalidasi dengan struct bayangan seperti ParallelValidator, tag field diambil dari rules WithRuleSet jika ada
+// field yang tag nya membandingkan dengan field lain tidak divalidasi per item supaya hasilnya tetap sama
+// contoh : err := validation.StructCtx(ctx, validate, user, validation.WithMaxErrors(50), validation.WithMaxErrorsPerPath(5))
+func StructCtx(ctx context.Context, validate *validator.Validate, value any, options ...StructOption) error {
+	config := &structConfig{}
+	for _, option := range options {
+		option(config)
+	}
+
+	if err := CheckStruct(validate, value); err != nil {
+		return err
+	}
+
+	current := reflect.ValueOf(value)
+	if current.Kind() == reflect.Pointer && !current.IsNil() {
+		current = current.Elem()
+	}
+
+	if config.maxErrors <= 0 || current.Kind() != reflect.Struct {
+		err := validate.StructCtx(ctx, value)
+
+		var validationErrors validator.ValidationErrors
+		if !errors.As(err, &validationErrors) {
+			return err
+		}
+
+		return config.limit(validationErrors)
+	}
+
+	return config.walk(ctx, validate, value, current)
+}
+
+// Omitted untuk mengambil jumlah error yang dibuang oleh StructCtx, 0 jika error tidak dipotong
+func Omitted(err error) int {
+	var truncated *TruncatedErrors
+	if errors.As(err, &truncated) {
+		return truncated.Omitted
+	}
+
+	return 0
+}
+
+// limit untuk memotong error sesuai batas per path lalu batas total
+func (c *structConfig) limit(validationErrors validator.ValidationErrors) error {
+	limiter := &errorLimiter{config: c, perPath: map[string]int{}}
+	for _, fieldError := range validationErrors {
+		if !limiter.add(fieldError) {
+			break
+		}
+	}
+
+	// error yang dibuang karena batas total juga dihitung di Omitted
+	limiter.omitted = len(validationErrors) - len(limiter.errors)
+	return limiter.result()
+}
+
+// walk untuk validasi field satu per satu dan berhenti saat error melebihi WithMaxErrors
+// validasi struct level (RegisterStructValidation) dijalankan sekali dengan StructExceptCtx tanpa field,
+// lalu error nya dibuang dari hasil setiap field dan ditambahkan di akhir seperti urutan dari validator
+func (c *structConfig) walk(ctx context.Context, validate *validator.Validate, value any, current reflect.Value) error {
+	structType := current.Type()
+
+	var names []string
+	for i := 0; i < structType.NumField(); i++ {
+		if structType.Field(i).IsExported() {
+			names = append(names, structType.Field(i).Name)
+		}
+	}
+
+	structErrors, err := validateExcept(ctx, validate, value, names)
+	if err != nil {
+		return err
+	}
+
+	root := ""
+	if name := structType.Name(); name != "" {
+		root = name + "."
+	}
+
+	limiter := &errorLimiter{config: c, perPath: map[string]int{}}
+	for i, name := range names {
+		field, _ := structType.FieldByName(name)
+
+		tag := fieldTag(validate, structType, field)
+
+		var fieldErrors []validator.FieldError
+		if prefix, dive, ok := splitDive(tag); ok && !hasCrossField(tag) {
+			fieldErrors, err = walkDive(ctx, validate, limiter, root, field, current.FieldByIndex(field.Index), prefix, dive)
+		} else {
+			others := append(append([]string{}, names[:i]...), names[i+1:]...)
+			fieldErrors, err = validateExcept(ctx, validate, value, others)
+			fieldErrors = fieldErrors[:len(fieldErrors)-len(structErrors)]
+		}
+		if err != nil {
+			return err
+		}
+
+		// walkDive sudah berhenti di tengah field jika batas terlewati
+		if !limiter.addAll(fieldErrors) || limiter.stopped {
+			return limiter.result()
+		}
+	}
+
+	limiter.addAll(structErrors)
+	return limiter.result()
+}
+
+// walkDive untuk validasi tag sebelum dive sekali lalu setiap item slice atau array satu per satu
+// error dari item yang sudah divalidasi langsung dimasukkan ke limiter supaya validasi bisa berhenti di tengah field
+func walkDive(ctx context.Context, validate *validator.Validate, limiter *errorLimiter, root string, field reflect.StructField, value reflect.Value, prefix, dive string) ([]validator.FieldError, error) {
+	if prefix != "" {
+		fieldErrors, err := validateShadow(ctx, validate, field, prefix, value)
+		if err != nil || len(fieldErrors) > 0 {
+			return rebase(fieldErrors, root, "", 0), err
+		}
+	}
+
+	if value.Kind() != reflect.Slice && value.Kind() != reflect.Array {
+		fieldErrors, err := validateShadow(ctx, validate, field, dive, value)
+		return rebase(fieldErrors, root, "", 0), err
+	}
+
+	// satu slice berisi satu item dipakai ulang untuk setiap item supaya index di error bisa digeser
+	single := reflect.MakeSlice(reflect.SliceOf(value.Type().Elem()), 1, 1)
+	for i := 0; i < value.Len(); i++ {
+		single.Index(0).Set(value.Index(i))
+
+		itemErrors, err := validateShadow(ctx, validate, field, dive, single)
+		if err != nil {
+			return nil, err
+		}
+
+		altName := fieldAltPrefix(itemErrors, parallelField{field: field})
+		if !limiter.addAll(rebase(itemErrors, root, altName, i)) {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// validateExcept untuk validasi struct tanpa field di excluded, error selain error validasi dikembalikan sebagai err
+func validateExcept(ctx context.Context, validate *validator.Validate, value any, excluded []string) ([]validator.FieldError, error) {
+	err := validate.StructExceptCtx(ctx, value, excluded...)
+
+	var validationErrors validator.ValidationErrors
+	if err != nil && !errors.As(err, &validationErrors) {
+		return nil, err
+	}
+
+	return validationErrors, nil
+}
+
+// errorLimiter untuk menampung error sesuai batas per path dan batas total
+type errorLimiter struct {
+	config  *structConfig
+	perPath map[string]int
+	errors  validator.ValidationErrors
+	omitted int
+	stopped bool
+}
+
+// add untuk menambahkan satu error, false jika error dibuang karena batas total sudah tercapai
+// error yang dibuang karena batas per path tetap mengembalikan true
+func (l *errorLimiter) add(fieldError validator.FieldError) bool {
+	if l.config.maxErrorsPerPath > 0 {
+		path := fieldPath(fieldError)
+		if l.perPath[path] >= l.config.maxErrorsPerPath {
+			l.omitted++
+			return true
+		}
+		l.perPath[path]++
+	}
+
+	if l.config.maxErrors > 0 && len(l.errors) >= l.config.maxErrors {
+		l.omitted++
+		return false
+	}
+
+	l.errors = append(l.errors, fieldError)
+	return true
+}
+
+// addAll untuk menambahkan semua error, false jika batas total terlewati dan validasi bisa berhenti
+// error setelah batas tetap dihitung di omitted
+func (l *errorLimiter) addAll(fieldErrors []validator.FieldError) bool {
+	full := false
+	for _, fieldError := range fieldErrors {
+		if !l.add(fieldError) {
+			full = true
+		}
+	}
+
+	if full {
+		l.stopped = true
+	}
+	return !full
+}
+
+// result untuk mengembalikan error yang ditampung, *TruncatedErrors jika ada error yang dibuang
+func (l *errorLimiter) result() error {
+	if l.omitted > 0 {
+		return &TruncatedErrors{Errors: l.errors, Omitted: l.omitted, Stopped: l.stopped}
+	}
+
+	if len(l.errors) == 0 {
+		return nil
+	}
+
+	return l.errors
+}
+
+// indexPattern untuk index slice, array dan key map di dalam path
+var indexPattern = regexp.MustCompile(`\[[^\]]*\]`)
+
+// fieldPath untuk mengambil Path error tanpa index, contoh : "Addresses[0].City" menjadi "Addresses[].City"
+func fieldPath(fieldError validator.FieldError) string {
+	return indexPattern.ReplaceAllString(Path(fieldError), "[]")
+}
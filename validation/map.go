@@ -0,0 +1,298 @@
+package validation
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// MapNamespace adalah nama root di namespace error dari ValidateMap, sama seperti nama struct pada StructCtx
+// sehingga Path menghasilkan "address.city" atau "addresses[0].city"
+const MapNamespace = "map"
+
+// Rules adalah aturan validasi untuk map[string]any, key berupa path dan value berupa tag
+// path memakai titik untuk object dan [] untuk setiap item array, value boleh berupa Rules untuk object
+// contoh :
+//
+//	validation.Rules{
+//		"username":        "required,email",
+//		"address":         "required",
+//		"address.city":    "required,min=2",
+//		"addresses":       "required,min=1",
+//		"addresses[].city": "required",
+//		"tags[]":          "required,ip",
+//		"profile": validation.Rules{"gender": "gender"},
+//	}
+type Rules map[string]any
+
+// MapRules adalah Rules yang sudah di compile menjadi tree, aman dipakai bersamaan
+type MapRules struct {
+	root *ruleNode
+}
+
+type ruleNode struct {
+	tag    string
+	fields map[string]*ruleNode
+	keys   []string
+	items  *ruleNode
+}
+
+// CompileRules untuk mengubah Rules menjadi MapRules
+// error jika value bukan string atau Rules, atau path tidak valid
+func CompileRules(rules Rules) (*MapRules, error) {
+	root := &ruleNode{}
+	if err := root.add("", rules); err != nil {
+		return nil, err
+	}
+
+	return &MapRules{root: root}, nil
+}
+
+// ValidateMap untuk validasi map[string]any menggunakan Rules tanpa harus membuat struct
+// error yang dikembalikan berupa validator.ValidationErrors dengan namespace seperti "map.addresses[0].city"
+func ValidateMap(ctx context.Context, validate *validator.Validate, data map[string]any, rules Rules) error {
+	mapRules, err := CompileRules(rules)
+	if err != nil {
+		return err
+	}
+
+	return mapRules.Validate(ctx, validate, data)
+}
+
+// Validate untuk validasi map[string]any menggunakan rules yang sudah di compile
+// rules untuk field dan item hanya dicek jika value nya berupa map dengan key string atau slice
+func (r *MapRules) Validate(ctx context.Context, validate *validator.Validate, data map[string]any) error {
+	var validationErrors validator.ValidationErrors
+	if err := r.root.validate(ctx, validate, data, "", &validationErrors); err != nil {
+		return err
+	}
+
+	if len(validationErrors) > 0 {
+		return validationErrors
+	}
+
+	return nil
+}
+
+// add untuk menambahkan rules ke node dengan prefix path
+func (n *ruleNode) add(prefix string, rules Rules) error {
+	for key, value := range rules {
+		path := key
+		if prefix != "" {
+			path = prefix + "." + key
+		}
+
+		switch rule := value.(type) {
+		case string:
+			node, err := n.node(path)
+			if err != nil {
+				return err
+			}
+			node.tag = rule
+		case Rules:
+			if err := n.add(path, rule); err != nil {
+				return err
+			}
+		case map[string]any:
+			if err := n.add(path, rule); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("rule %q must be a tag string or nested rules, got %T", path, value)
+		}
+	}
+
+	return nil
+}
+
+// node untuk mencari atau membuat node dari path seperti "addresses[].city"
+func (n *ruleNode) node(path string) (*ruleNode, error) {
+	current := n
+	for _, segment := range strings.Split(path, ".") {
+		name := strings.TrimRight(segment, "[]")
+		depth := strings.Count(segment[len(name):], "[]")
+		if name == "" || strings.ContainsAny(name, "[]") || len(segment) != len(name)+depth*2 {
+			return nil, fmt.Errorf("invalid rule path %q", path)
+		}
+
+		if current.fields == nil {
+			current.fields = make(map[string]*ruleNode)
+		}
+
+		child, ok := current.fields[name]
+		if !ok {
+			child = &ruleNode{}
+			current.fields[name] = child
+			current.keys = append(current.keys, name)
+			slices.Sort(current.keys)
+		}
+		current = child
+
+		for i := 0; i < depth; i++ {
+			if current.items == nil {
+				current.items = &ruleNode{}
+			}
+			current = current.items
+		}
+	}
+
+	return current, nil
+}
+
+// validate untuk validasi value menggunakan tag dari node lalu lanjut ke field dan item di dalamnya
+func (n *ruleNode) validate(ctx context.Context, validate *validator.Validate, value any, path string, validationErrors *validator.ValidationErrors) error {
+	if n.tag != "" {
+		err := VarCtx(ctx, validate, value, n.tag)
+		if err != nil {
+			fieldErrors, ok := err.(validator.ValidationErrors)
+			if !ok {
+				return err
+			}
+
+			// error dari dive pada map tidak punya urutan yang pasti, index slice diurutkan sebagai angka
+			slices.SortStableFunc(fieldErrors, func(a, b validator.FieldError) int {
+				return ComparePath(a.Namespace(), b.Namespace())
+			})
+
+			for _, fieldError := range fieldErrors {
+				*validationErrors = append(*validationErrors, newMapFieldError(fieldError, path))
+			}
+		}
+	}
+
+	reflectValue := reflect.ValueOf(value)
+	switch reflectValue.Kind() {
+	case reflect.Map:
+		if reflectValue.Type().Key().Kind() != reflect.String {
+			return nil
+		}
+
+		for _, key := range n.keys {
+			var child any
+			if item := reflectValue.MapIndex(reflect.ValueOf(key).Convert(reflectValue.Type().Key())); item.IsValid() {
+				child = item.Interface()
+			}
+
+			if err := n.fields[key].validate(ctx, validate, child, joinPath(path, key), validationErrors); err != nil {
+				return err
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		if n.items == nil {
+			return nil
+		}
+
+		for i := 0; i < reflectValue.Len(); i++ {
+			item := reflectValue.Index(i).Interface()
+			if err := n.items.validate(ctx, validate, item, fmt.Sprintf("%s[%d]", path, i), validationErrors); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// mapFieldError adalah validator.FieldError dengan namespace dan field dari path di map
+type mapFieldError struct {
+	validator.FieldError
+	namespace string
+	field     string
+}
+
+// newMapFieldError untuk menggabungkan path dari map dengan namespace error hasil VarCtx
+// misal path "servers" dan namespace "[1]" menjadi "map.servers[1]"
+func newMapFieldError(fieldError validator.FieldError, path string) *mapFieldError {
+	field := path
+	if index := strings.LastIndex(path, "."); index != -1 {
+		field = path[index+1:]
+	}
+
+	switch {
+	case fieldError.Field() == "":
+	case strings.HasPrefix(fieldError.Field(), "["):
+		field += fieldError.Field()
+	default:
+		field = fieldError.Field()
+	}
+
+	return &mapFieldError{
+		FieldError: fieldError,
+		namespace:  MapNamespace + "." + path + fieldError.Namespace(),
+		field:      field,
+	}
+}
+
+func (e *mapFieldError) Namespace() string {
+	return e.namespace
+}
+
+func (e *mapFieldError) StructNamespace() string {
+	return e.namespace
+}
+
+func (e *mapFieldError) Field() string {
+	return e.field
+}
+
+func (e *mapFieldError) StructField() string {
+	return e.field
+}
+
+func (e *mapFieldError) Error() string {
+	return fmt.Sprintf("Key: '%s' Error:Field validation for '%s' failed on the '%s' tag", e.namespace, e.field, e.Tag())
+}
+
+// ComparePath untuk membandingkan dua path secara natural, deretan angka dibandingkan sebagai angka
+// contoh : "items[2]" sebelum "items[10]" dan "user2" sebelum "user10"
+func ComparePath(a, b string) int {
+	for a != "" && b != "" {
+		var chunkA, chunkB string
+		chunkA, a = pathChunk(a)
+		chunkB, b = pathChunk(b)
+
+		if isDigit(chunkA[0]) && isDigit(chunkB[0]) {
+			numberA, numberB := strings.TrimLeft(chunkA, "0"), strings.TrimLeft(chunkB, "0")
+			if result := cmp.Compare(len(numberA), len(numberB)); result != 0 {
+				return result
+			}
+			if result := strings.Compare(numberA, numberB); result != 0 {
+				return result
+			}
+		}
+
+		if result := strings.Compare(chunkA, chunkB); result != 0 {
+			return result
+		}
+	}
+
+	return cmp.Compare(len(a), len(b))
+}
+
+// pathChunk untuk mengambil deretan angka atau deretan selain angka dari awal path
+func pathChunk(path string) (string, string) {
+	digit := isDigit(path[0])
+
+	end := 1
+	for end < len(path) && isDigit(path[end]) == digit {
+		end++
+	}
+
+	return path[:end], path[end:]
+}
+
+func isDigit(char byte) bool {
+	return '0' <= char && char <= '9'
+}
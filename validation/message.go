@@ -0,0 +1,235 @@
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/go-playground/locales/en"
+	"github.com/go-playground/locales/id"
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+)
+
+// placeholder yang bisa dipakai di dalam message
+const (
+	PlaceholderField = "{field}"
+	PlaceholderParam = "{param}"
+	PlaceholderValue = "{value}"
+
+	// PlaceholderValues berisi value yang valid dari set milik tag, misal inset=gender atau category
+	PlaceholderValues = "{values}"
+)
+
+const (
+	// DefaultMessage dipakai jika tag belum punya message di catalog
+	DefaultMessage = "{field} is invalid"
+
+	// DefaultFieldName dipakai untuk {field} saat validasi variabel (VarCtx) yang tidak punya nama field
+	DefaultFieldName = "value"
+)
+
+// locale yang didukung oleh catalog bawaan
+const (
+	LocaleEnglish    = "en"
+	LocaleIndonesian = "id"
+)
+
+// key khusus di dalam message per locale
+const (
+	keyDefaultMessage = "_default"
+	keyDefaultField   = "_field"
+	keySuggestion     = "_suggestion"
+)
+
+// Catalog untuk menyimpan message dari setiap tag validasi per locale
+// locale dicari menggunakan universal-translator, jika tidak ditemukan dipakai locale en
+// register semua message sebelum catalog dipakai, Register tidak aman dipanggil bersamaan dengan Message
+type Catalog struct {
+	universal *ut.UniversalTranslator
+	messages  map[string]map[string]string
+	suggester *Suggester
+}
+
+// NewCatalog untuk membuat catalog yang sudah berisi message bawaan locale en dan id
+func NewCatalog() *Catalog {
+	english := en.New()
+
+	return &Catalog{
+		universal: ut.New(english, english, id.New()),
+		messages: map[string]map[string]string{
+			LocaleEnglish:    maps.Clone(messagesEnglish),
+			LocaleIndonesian: maps.Clone(messagesIndonesian),
+		},
+	}
+}
+
+// Register untuk menambahkan atau mengganti message bahasa inggris dari sebuah tag
+// tambahkan suffix .string, .items atau .number pada tag jika message berbeda sesuai kind field
+// contoh : catalog.Register("min.string", "{field} must have {param} characters")
+func (c *Catalog) Register(tag, message string) {
+	c.messages[LocaleEnglish][tag] = message
+}
+
+// RegisterLocale untuk menambahkan atau mengganti message dari sebuah tag pada locale tertentu
+// contoh : catalog.RegisterLocale("id", "min.string", "{field} minimal {param} karakter")
+func (c *Catalog) RegisterLocale(locale, tag, message string) error {
+	messages, ok := c.messages[locale]
+	if !ok {
+		return fmt.Errorf("locale %q is not supported", locale)
+	}
+
+	messages[tag] = message
+	return nil
+}
+
+// Message untuk membuat message bahasa inggris dari satu error field
+func (c *Catalog) Message(fieldError validator.FieldError) string {
+	return c.Translate(fieldError, LocaleEnglish)
+}
+
+// Translate untuk membuat message dari satu error field sesuai locale
+// contoh : catalog.Translate(errorField, "id")
+func (c *Catalog) Translate(fieldError validator.FieldError, locale string) string {
+	messages := c.localeMessages(locale)
+	message := c.lookup(messages, fieldError)
+
+	field := fieldError.Field()
+	if field == "" {
+		field = messages[keyDefaultField]
+	}
+
+	var values string
+	if set, ok := fieldSet(fieldError); ok {
+		values = strings.Join(set.Values, " ")
+	}
+
+	message = strings.NewReplacer(
+		PlaceholderField, field,
+		PlaceholderParam, fieldError.Param(),
+		PlaceholderValues, values,
+		PlaceholderValue, fmt.Sprint(fieldError.Value()),
+	).Replace(message)
+
+	return c.withSuggestion(message, messages, fieldError)
+}
+
+// Messages untuk mengubah validator.ValidationErrors menjadi list message bahasa inggris
+// error selain validator.ValidationErrors dikembalikan apa adanya menggunakan err.Error()
+func (c *Catalog) Messages(err error) []string {
+	return c.TranslateAll(err, LocaleEnglish)
+}
+
+// TranslateAll untuk mengubah validator.ValidationErrors menjadi list message sesuai locale
+// contoh : catalog.TranslateAll(err, "id")
+func (c *Catalog) TranslateAll(err error, locale string) []string {
+	if err == nil {
+		return nil
+	}
+
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		return []string{err.Error()}
+	}
+
+	messages := make([]string, 0, len(validationErrors))
+	for _, fieldError := range validationErrors {
+		messages = append(messages, c.Translate(fieldError, locale))
+	}
+
+	return messages
+}
+
+// Tags untuk mengambil semua tag yang punya message di locale en, tanpa suffix kind
+func (c *Catalog) Tags() []string {
+	tags := make([]string, 0, len(c.messages[LocaleEnglish]))
+	for key := range c.messages[LocaleEnglish] {
+		if strings.HasPrefix(key, "_") {
+			continue
+		}
+
+		tag, _, _ := strings.Cut(key, ".")
+		if !slices.Contains(tags, tag) {
+			tags = append(tags, tag)
+		}
+	}
+
+	slices.Sort(tags)
+	return tags
+}
+
+// MissingTranslations untuk mengecek key message di locale en yang belum diterjemahkan ke locale lain
+func (c *Catalog) MissingTranslations(locale string) []string {
+	messages := c.messages[locale]
+
+	var missing []string
+	for key := range c.messages[LocaleEnglish] {
+		if _, ok := messages[key]; !ok {
+			missing = append(missing, key)
+		}
+	}
+
+	slices.Sort(missing)
+	return missing
+}
+
+// localeMessages untuk mencari message dari locale menggunakan universal-translator
+// region pada locale dibuang lebih dulu, contoh : "id-ID" dan "id_ID" memakai locale id
+// locale yang tidak didukung akan memakai locale fallback (en)
+func (c *Catalog) localeMessages(locale string) map[string]string {
+	translator, _ := c.universal.FindTranslator(baseLocale(locale))
+	if messages, ok := c.messages[translator.Locale()]; ok {
+		return messages
+	}
+
+	return c.messages[LocaleEnglish]
+}
+
+// baseLocale untuk mengambil bahasa dari locale tanpa region, contoh : "id-ID" menjadi "id"
+func baseLocale(locale string) string {
+	language, _, _ := strings.Cut(strings.TrimSpace(locale), "-")
+	language, _, _ = strings.Cut(language, "_")
+	return strings.ToLower(language)
+}
+
+// lookup untuk mencari message berdasarkan tag (alias) lalu actual tag
+// key dengan suffix kind dicek lebih dulu sebelum key tanpa suffix
+// jika tidak ada di locale yang diminta, dicari di locale en sebelum memakai message default
+func (c *Catalog) lookup(messages map[string]string, fieldError validator.FieldError) string {
+	suffix := kindSuffix(fieldError.Kind())
+
+	for _, candidates := range []map[string]string{messages, c.messages[LocaleEnglish]} {
+		for _, tag := range []string{fieldError.Tag(), fieldError.ActualTag()} {
+			if suffix != "" {
+				if message, ok := candidates[tag+suffix]; ok {
+					return message
+				}
+			}
+
+			if message, ok := candidates[tag]; ok {
+				return message
+			}
+		}
+	}
+
+	return messages[keyDefaultMessage]
+}
+
+// kindSuffix untuk mengelompokkan kind field menjadi suffix key message
+func kindSuffix(kind reflect.Kind) string {
+	switch kind {
+	case reflect.String:
+		return ".string"
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return ".items"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return ".number"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,196 @@
+package validation
+
+// messagesEnglish berisi message bahasa inggris untuk tag bawaan validator dan custom tag project
+// key dengan suffix .string, .items atau .number dipakai sesuai kind dari field yang divalidasi
+var messagesEnglish = map[string]string{
+	keyDefaultMessage:               DefaultMessage,
+	keyDefaultField:                 DefaultFieldName,
+	keySuggestion:                   "did you mean '{suggestion}'?",
+	"required":                      "{field} is required",
+	"min.string":                    "{field} must be at least {param} characters",
+	"min.items":                     "{field} must contain at least {param} items",
+	"min.number":                    "{field} must be {param} or greater",
+	"max.string":                    "{field} must be at most {param} characters",
+	"max.items":                     "{field} must contain at most {param} items",
+	"max.number":                    "{field} must be {param} or less",
+	"len.string":                    "{field} must be exactly {param} characters",
+	"len.items":                     "{field} must contain exactly {param} items",
+	"len.number":                    "{field} must be equal to {param}",
+	"eq":                            "{field} must be equal to {param}",
+	"ne":                            "{field} must not be equal to {param}",
+	"gt":                            "{field} must be greater than {param}",
+	"gte":                           "{field} must be greater than or equal to {param}",
+	"lt":                            "{field} must be less than {param}",
+	"lte":                           "{field} must be less than or equal to {param}",
+	"gt.string":                     "{field} must be longer than {param} characters",
+	"gt.items":                      "{field} must contain more than {param} items",
+	"gte.string":                    "{field} must be at least {param} characters",
+	"gte.items":                     "{field} must contain at least {param} items",
+	"lt.string":                     "{field} must be shorter than {param} characters",
+	"lt.items":                      "{field} must contain fewer than {param} items",
+	"lte.string":                    "{field} must be at most {param} characters",
+	"lte.items":                     "{field} must contain at most {param} items",
+	"eq.string":                     "{field} must be equal to '{param}'",
+	"eq.items":                      "{field} must contain exactly {param} items",
+	"ne.string":                     "{field} must not be equal to '{param}'",
+	"ne.items":                      "{field} must not contain exactly {param} items",
+	"eqfield":                       "{field} must be equal to {param}",
+	"nefield":                       "{field} must not be equal to {param}",
+	"email":                         "{field} must be a valid email address, got '{value}'",
+	"ip":                            "{field} must be a valid IP address, got '{value}'",
+	"ipv4":                          "{field} must be a valid IPv4 address, got '{value}'",
+	"ipv6":                          "{field} must be a valid IPv6 address, got '{value}'",
+	"url":                           "{field} must be a valid URL, got '{value}'",
+	"uuid":                          "{field} must be a valid UUID, got '{value}'",
+	"alpha":                         "{field} can only contain alphabetic characters",
+	"alphanum":                      "{field} can only contain alphanumeric characters",
+	"numeric":                       "{field} must be a valid numeric value",
+	"oneof":                         "{field} must be one of [{param}], got '{value}'",
+	"required_if":                   "{field} is required when [{param}]",
+	"required_unless":               "{field} is required unless [{param}]",
+	"skip_unless":                   "{field} is required when [{param}]",
+	"required_with":                 "{field} is required when {param} is present",
+	"required_with_all":             "{field} is required when all of [{param}] are present",
+	"required_without":              "{field} is required when {param} is not present",
+	"required_without_all":          "{field} is required when none of [{param}] are present",
+	"excluded_if":                   "{field} must be empty when [{param}]",
+	"excluded_unless":               "{field} must be empty unless [{param}]",
+	"excluded_with":                 "{field} must be empty when {param} is present",
+	"excluded_with_all":             "{field} must be empty when all of [{param}] are present",
+	"excluded_without":              "{field} must be empty when {param} is not present",
+	"excluded_without_all":          "{field} must be empty when none of [{param}] are present",
+	"isdefault":                     "{field} must be empty",
+	"eq_ignore_case":                "{field} must be equal to '{param}' ignoring case",
+	"ne_ignore_case":                "{field} must not be equal to '{param}' ignoring case",
+	"eqcsfield":                     "{field} must be equal to {param}",
+	"necsfield":                     "{field} must not be equal to {param}",
+	"gtfield":                       "{field} must be greater than {param}",
+	"gtcsfield":                     "{field} must be greater than {param}",
+	"gtefield":                      "{field} must be greater than or equal to {param}",
+	"gtecsfield":                    "{field} must be greater than or equal to {param}",
+	"ltfield":                       "{field} must be less than {param}",
+	"ltcsfield":                     "{field} must be less than {param}",
+	"ltefield":                      "{field} must be less than or equal to {param}",
+	"ltecsfield":                    "{field} must be less than or equal to {param}",
+	"fieldcontains":                 "{field} must contain the value of {param}",
+	"fieldexcludes":                 "{field} must not contain the value of {param}",
+	"alphaunicode":                  "{field} can only contain letters",
+	"alphanumunicode":               "{field} can only contain letters and numbers",
+	"boolean":                       "{field} must be a valid boolean value",
+	"number":                        "{field} must be a valid number",
+	"hexadecimal":                   "{field} must be a valid hexadecimal value",
+	"hexcolor":                      "{field} must be a valid HEX color",
+	"rgb":                           "{field} must be a valid RGB color",
+	"rgba":                          "{field} must be a valid RGBA color",
+	"hsl":                           "{field} must be a valid HSL color",
+	"hsla":                          "{field} must be a valid HSLA color",
+	"iscolor":                       "{field} must be a valid color",
+	"e164":                          "{field} must be a valid E.164 phone number, got '{value}'",
+	"http_url":                      "{field} must be a valid HTTP URL, got '{value}'",
+	"uri":                           "{field} must be a valid URI, got '{value}'",
+	"urn_rfc2141":                   "{field} must be a valid URN, got '{value}'",
+	"file":                          "{field} must be an existing file",
+	"filepath":                      "{field} must be a valid file path",
+	"dir":                           "{field} must be an existing directory",
+	"dirpath":                       "{field} must be a valid directory path",
+	"image":                         "{field} must be a valid image file",
+	"base64":                        "{field} must be a valid Base64 string",
+	"base64url":                     "{field} must be a valid URL-safe Base64 string",
+	"base64rawurl":                  "{field} must be a valid unpadded URL-safe Base64 string",
+	"contains":                      "{field} must contain '{param}'",
+	"containsany":                   "{field} must contain at least one of the characters '{param}'",
+	"containsrune":                  "{field} must contain '{param}'",
+	"excludes":                      "{field} must not contain '{param}'",
+	"excludesall":                   "{field} must not contain any of the characters '{param}'",
+	"excludesrune":                  "{field} must not contain '{param}'",
+	"startswith":                    "{field} must start with '{param}'",
+	"startsnotwith":                 "{field} must not start with '{param}'",
+	"endswith":                      "{field} must end with '{param}'",
+	"endsnotwith":                   "{field} must not end with '{param}'",
+	"isbn":                          "{field} must be a valid ISBN",
+	"isbn10":                        "{field} must be a valid ISBN-10",
+	"isbn13":                        "{field} must be a valid ISBN-13",
+	"issn":                          "{field} must be a valid ISSN",
+	"eth_addr":                      "{field} must be a valid Ethereum address",
+	"eth_addr_checksum":             "{field} must be a valid checksummed Ethereum address",
+	"btc_addr":                      "{field} must be a valid Bitcoin address",
+	"btc_addr_bech32":               "{field} must be a valid Bech32 Bitcoin address",
+	"uuid3":                         "{field} must be a valid version 3 UUID, got '{value}'",
+	"uuid4":                         "{field} must be a valid version 4 UUID, got '{value}'",
+	"uuid5":                         "{field} must be a valid version 5 UUID, got '{value}'",
+	"uuid_rfc4122":                  "{field} must be a valid RFC 4122 UUID, got '{value}'",
+	"uuid3_rfc4122":                 "{field} must be a valid RFC 4122 version 3 UUID, got '{value}'",
+	"uuid4_rfc4122":                 "{field} must be a valid RFC 4122 version 4 UUID, got '{value}'",
+	"uuid5_rfc4122":                 "{field} must be a valid RFC 4122 version 5 UUID, got '{value}'",
+	"ulid":                          "{field} must be a valid ULID",
+	"md4":                           "{field} must be a valid MD4 hash",
+	"md5":                           "{field} must be a valid MD5 hash",
+	"sha256":                        "{field} must be a valid SHA-256 hash",
+	"sha384":                        "{field} must be a valid SHA-384 hash",
+	"sha512":                        "{field} must be a valid SHA-512 hash",
+	"ripemd128":                     "{field} must be a valid RIPEMD-128 hash",
+	"ripemd160":                     "{field} must be a valid RIPEMD-160 hash",
+	"tiger128":                      "{field} must be a valid TIGER128 hash",
+	"tiger160":                      "{field} must be a valid TIGER160 hash",
+	"tiger192":                      "{field} must be a valid TIGER192 hash",
+	"ascii":                         "{field} can only contain ASCII characters",
+	"printascii":                    "{field} can only contain printable ASCII characters",
+	"multibyte":                     "{field} must contain multibyte characters",
+	"datauri":                       "{field} must be a valid data URI",
+	"latitude":                      "{field} must be a valid latitude",
+	"longitude":                     "{field} must be a valid longitude",
+	"ssn":                           "{field} must be a valid SSN",
+	"cidr":                          "{field} must be a valid CIDR notation, got '{value}'",
+	"cidrv4":                        "{field} must be a valid IPv4 CIDR notation, got '{value}'",
+	"cidrv6":                        "{field} must be a valid IPv6 CIDR notation, got '{value}'",
+	"tcp_addr":                      "{field} must be a valid TCP address",
+	"tcp4_addr":                     "{field} must be a valid TCPv4 address",
+	"tcp6_addr":                     "{field} must be a valid TCPv6 address",
+	"udp_addr":                      "{field} must be a valid UDP address",
+	"udp4_addr":                     "{field} must be a valid UDPv4 address",
+	"udp6_addr":                     "{field} must be a valid UDPv6 address",
+	"ip_addr":                       "{field} must be a resolvable IP address",
+	"ip4_addr":                      "{field} must be a resolvable IPv4 address",
+	"ip6_addr":                      "{field} must be a resolvable IPv6 address",
+	"unix_addr":                     "{field} must be a valid Unix address",
+	"mac":                           "{field} must be a valid MAC address",
+	"hostname":                      "{field} must be a valid hostname",
+	"hostname_rfc1123":              "{field} must be a valid RFC 1123 hostname",
+	"hostname_port":                 "{field} must be a valid host and port",
+	"fqdn":                          "{field} must be a valid fully qualified domain name",
+	"unique":                        "{field} must contain unique values",
+	"html":                          "{field} must be valid HTML",
+	"html_encoded":                  "{field} must be HTML-encoded",
+	"url_encoded":                   "{field} must be URL-encoded",
+	"json":                          "{field} must be valid JSON",
+	"jwt":                           "{field} must be a valid JWT",
+	"lowercase":                     "{field} must be lowercase",
+	"uppercase":                     "{field} must be uppercase",
+	"datetime":                      "{field} must be a valid datetime in format {param}",
+	"timezone":                      "{field} must be a valid time zone",
+	"iso3166_1_alpha2":              "{field} must be a valid ISO 3166-1 alpha-2 country code",
+	"iso3166_1_alpha3":              "{field} must be a valid ISO 3166-1 alpha-3 country code",
+	"iso3166_1_alpha_numeric":       "{field} must be a valid ISO 3166-1 numeric country code",
+	"country_code":                  "{field} must be a valid ISO 3166-1 country code",
+	"iso3166_2":                     "{field} must be a valid ISO 3166-2 subdivision code",
+	"iso4217":                       "{field} must be a valid ISO 4217 currency code",
+	"iso4217_numeric":               "{field} must be a valid ISO 4217 numeric currency code",
+	"bcp47_language_tag":            "{field} must be a valid BCP 47 language tag",
+	"postcode_iso3166_alpha2":       "{field} must be a valid postcode for country {param}",
+	"postcode_iso3166_alpha2_field": "{field} must be a valid postcode for the country in {param}",
+	"bic":                           "{field} must be a valid BIC",
+	"semver":                        "{field} must be a valid semantic version",
+	"dns_rfc1035_label":             "{field} must be a valid DNS label",
+	"credit_card":                   "{field} must be a valid credit card number",
+	"cve":                           "{field} must be a valid CVE identifier",
+	"luhn_checksum":                 "{field} must have a valid Luhn checksum",
+	"mongodb":                       "{field} must be a valid MongoDB ObjectID",
+	"cron":                          "{field} must be a valid cron expression",
+	"spicedb":                       "{field} must be a valid SpiceDB identifier",
+	TagCategory:                     "{field} must be one of [{values}], got '{value}'",
+	TagMinCategory:                  "{field} must contain at least {param} categories from [a b c d e]",
+	TagGender:                       "{field} must be one of [{values}], got '{value}'",
+	TagInSet:                        "{field} must be one of [{values}], got '{value}'",
+	TagType:                         "{field} must be a valid {param}, got '{value}'",
+	TagColumnCount:                  "row must have {param} columns, got {value}",
+}
@@ -0,0 +1,196 @@
+package validation
+
+// messagesIndonesian berisi message bahasa indonesia untuk tag bawaan validator dan custom tag project
+// key yang dipakai harus sama dengan messagesEnglish
+var messagesIndonesian = map[string]string{
+	keyDefaultMessage:               "{field} tidak valid",
+	keyDefaultField:                 "nilai",
+	keySuggestion:                   "mungkin maksud anda '{suggestion}'?",
+	"required":                      "{field} wajib diisi",
+	"min.string":                    "{field} minimal {param} karakter",
+	"min.items":                     "{field} minimal berisi {param} item",
+	"min.number":                    "{field} minimal {param}",
+	"max.string":                    "{field} maksimal {param} karakter",
+	"max.items":                     "{field} maksimal berisi {param} item",
+	"max.number":                    "{field} maksimal {param}",
+	"len.string":                    "{field} harus {param} karakter",
+	"len.items":                     "{field} harus berisi {param} item",
+	"len.number":                    "{field} harus sama dengan {param}",
+	"eq":                            "{field} harus sama dengan {param}",
+	"ne":                            "{field} tidak boleh sama dengan {param}",
+	"gt":                            "{field} harus lebih besar dari {param}",
+	"gte":                           "{field} harus lebih besar atau sama dengan {param}",
+	"lt":                            "{field} harus lebih kecil dari {param}",
+	"lte":                           "{field} harus lebih kecil atau sama dengan {param}",
+	"gt.string":                     "{field} harus lebih dari {param} karakter",
+	"gt.items":                      "{field} harus berisi lebih dari {param} item",
+	"gte.string":                    "{field} minimal {param} karakter",
+	"gte.items":                     "{field} minimal berisi {param} item",
+	"lt.string":                     "{field} harus kurang dari {param} karakter",
+	"lt.items":                      "{field} harus berisi kurang dari {param} item",
+	"lte.string":                    "{field} maksimal {param} karakter",
+	"lte.items":                     "{field} maksimal berisi {param} item",
+	"eq.string":                     "{field} harus sama dengan '{param}'",
+	"eq.items":                      "{field} harus berisi {param} item",
+	"ne.string":                     "{field} tidak boleh sama dengan '{param}'",
+	"ne.items":                      "{field} tidak boleh berisi tepat {param} item",
+	"eqfield":                       "{field} harus sama dengan {param}",
+	"nefield":                       "{field} tidak boleh sama dengan {param}",
+	"email":                         "{field} harus berupa alamat email yang valid, bukan '{value}'",
+	"ip":                            "{field} harus berupa alamat IP yang valid, bukan '{value}'",
+	"ipv4":                          "{field} harus berupa alamat IPv4 yang valid, bukan '{value}'",
+	"ipv6":                          "{field} harus berupa alamat IPv6 yang valid, bukan '{value}'",
+	"url":                           "{field} harus berupa URL yang valid, bukan '{value}'",
+	"uuid":                          "{field} harus berupa UUID yang valid, bukan '{value}'",
+	"alpha":                         "{field} hanya boleh berisi huruf",
+	"alphanum":                      "{field} hanya boleh berisi huruf dan angka",
+	"numeric":                       "{field} harus berupa angka",
+	"oneof":                         "{field} harus salah satu dari [{param}], bukan '{value}'",
+	"required_if":                   "{field} wajib diisi jika [{param}]",
+	"required_unless":               "{field} wajib diisi kecuali [{param}]",
+	"skip_unless":                   "{field} wajib diisi jika [{param}]",
+	"required_with":                 "{field} wajib diisi jika {param} diisi",
+	"required_with_all":             "{field} wajib diisi jika semua [{param}] diisi",
+	"required_without":              "{field} wajib diisi jika {param} tidak diisi",
+	"required_without_all":          "{field} wajib diisi jika semua [{param}] tidak diisi",
+	"excluded_if":                   "{field} harus kosong jika [{param}]",
+	"excluded_unless":               "{field} harus kosong kecuali [{param}]",
+	"excluded_with":                 "{field} harus kosong jika {param} diisi",
+	"excluded_with_all":             "{field} harus kosong jika semua [{param}] diisi",
+	"excluded_without":              "{field} harus kosong jika {param} tidak diisi",
+	"excluded_without_all":          "{field} harus kosong jika semua [{param}] tidak diisi",
+	"isdefault":                     "{field} harus kosong",
+	"eq_ignore_case":                "{field} harus sama dengan '{param}' tanpa membedakan huruf besar kecil",
+	"ne_ignore_case":                "{field} tidak boleh sama dengan '{param}' tanpa membedakan huruf besar kecil",
+	"eqcsfield":                     "{field} harus sama dengan {param}",
+	"necsfield":                     "{field} tidak boleh sama dengan {param}",
+	"gtfield":                       "{field} harus lebih besar dari {param}",
+	"gtcsfield":                     "{field} harus lebih besar dari {param}",
+	"gtefield":                      "{field} harus lebih besar atau sama dengan {param}",
+	"gtecsfield":                    "{field} harus lebih besar atau sama dengan {param}",
+	"ltfield":                       "{field} harus lebih kecil dari {param}",
+	"ltcsfield":                     "{field} harus lebih kecil dari {param}",
+	"ltefield":                      "{field} harus lebih kecil atau sama dengan {param}",
+	"ltecsfield":                    "{field} harus lebih kecil atau sama dengan {param}",
+	"fieldcontains":                 "{field} harus mengandung nilai dari {param}",
+	"fieldexcludes":                 "{field} tidak boleh mengandung nilai dari {param}",
+	"alphaunicode":                  "{field} hanya boleh berisi huruf",
+	"alphanumunicode":               "{field} hanya boleh berisi huruf dan angka",
+	"boolean":                       "{field} harus berupa nilai boolean yang valid",
+	"number":                        "{field} harus berupa bilangan yang valid",
+	"hexadecimal":                   "{field} harus berupa bilangan heksadesimal yang valid",
+	"hexcolor":                      "{field} harus berupa warna HEX yang valid",
+	"rgb":                           "{field} harus berupa warna RGB yang valid",
+	"rgba":                          "{field} harus berupa warna RGBA yang valid",
+	"hsl":                           "{field} harus berupa warna HSL yang valid",
+	"hsla":                          "{field} harus berupa warna HSLA yang valid",
+	"iscolor":                       "{field} harus berupa warna yang valid",
+	"e164":                          "{field} harus berupa nomor telepon E.164 yang valid, bukan '{value}'",
+	"http_url":                      "{field} harus berupa URL HTTP yang valid, bukan '{value}'",
+	"uri":                           "{field} harus berupa URI yang valid, bukan '{value}'",
+	"urn_rfc2141":                   "{field} harus berupa URN yang valid, bukan '{value}'",
+	"file":                          "{field} harus berupa file yang ada",
+	"filepath":                      "{field} harus berupa path file yang valid",
+	"dir":                           "{field} harus berupa direktori yang ada",
+	"dirpath":                       "{field} harus berupa path direktori yang valid",
+	"image":                         "{field} harus berupa file gambar yang valid",
+	"base64":                        "{field} harus berupa string Base64 yang valid",
+	"base64url":                     "{field} harus berupa string Base64 URL-safe yang valid",
+	"base64rawurl":                  "{field} harus berupa string Base64 URL-safe tanpa padding yang valid",
+	"contains":                      "{field} harus mengandung '{param}'",
+	"containsany":                   "{field} harus mengandung minimal satu karakter dari '{param}'",
+	"containsrune":                  "{field} harus mengandung '{param}'",
+	"excludes":                      "{field} tidak boleh mengandung '{param}'",
+	"excludesall":                   "{field} tidak boleh mengandung karakter dari '{param}'",
+	"excludesrune":                  "{field} tidak boleh mengandung '{param}'",
+	"startswith":                    "{field} harus diawali dengan '{param}'",
+	"startsnotwith":                 "{field} tidak boleh diawali dengan '{param}'",
+	"endswith":                      "{field} harus diakhiri dengan '{param}'",
+	"endsnotwith":                   "{field} tidak boleh diakhiri dengan '{param}'",
+	"isbn":                          "{field} harus berupa ISBN yang valid",
+	"isbn10":                        "{field} harus berupa ISBN-10 yang valid",
+	"isbn13":                        "{field} harus berupa ISBN-13 yang valid",
+	"issn":                          "{field} harus berupa ISSN yang valid",
+	"eth_addr":                      "{field} harus berupa alamat Ethereum yang valid",
+	"eth_addr_checksum":             "{field} harus berupa alamat Ethereum dengan checksum yang valid",
+	"btc_addr":                      "{field} harus berupa alamat Bitcoin yang valid",
+	"btc_addr_bech32":               "{field} harus berupa alamat Bitcoin Bech32 yang valid",
+	"uuid3":                         "{field} harus berupa UUID versi 3 yang valid, bukan '{value}'",
+	"uuid4":                         "{field} harus berupa UUID versi 4 yang valid, bukan '{value}'",
+	"uuid5":                         "{field} harus berupa UUID versi 5 yang valid, bukan '{value}'",
+	"uuid_rfc4122":                  "{field} harus berupa UUID RFC 4122 yang valid, bukan '{value}'",
+	"uuid3_rfc4122":                 "{field} harus berupa UUID RFC 4122 versi 3 yang valid, bukan '{value}'",
+	"uuid4_rfc4122":                 "{field} harus berupa UUID RFC 4122 versi 4 yang valid, bukan '{value}'",
+	"uuid5_rfc4122":                 "{field} harus berupa UUID RFC 4122 versi 5 yang valid, bukan '{value}'",
+	"ulid":                          "{field} harus berupa ULID yang valid",
+	"md4":                           "{field} harus berupa hash MD4 yang valid",
+	"md5":                           "{field} harus berupa hash MD5 yang valid",
+	"sha256":                        "{field} harus berupa hash SHA-256 yang valid",
+	"sha384":                        "{field} harus berupa hash SHA-384 yang valid",
+	"sha512":                        "{field} harus berupa hash SHA-512 yang valid",
+	"ripemd128":                     "{field} harus berupa hash RIPEMD-128 yang valid",
+	"ripemd160":                     "{field} harus berupa hash RIPEMD-160 yang valid",
+	"tiger128":                      "{field} harus berupa hash TIGER128 yang valid",
+	"tiger160":                      "{field} harus berupa hash TIGER160 yang valid",
+	"tiger192":                      "{field} harus berupa hash TIGER192 yang valid",
+	"ascii":                         "{field} hanya boleh berisi karakter ASCII",
+	"printascii":                    "{field} hanya boleh berisi karakter ASCII yang bisa dicetak",
+	"multibyte":                     "{field} harus mengandung karakter multibyte",
+	"datauri":                       "{field} harus berupa data URI yang valid",
+	"latitude":                      "{field} harus berupa latitude yang valid",
+	"longitude":                     "{field} harus berupa longitude yang valid",
+	"ssn":                           "{field} harus berupa SSN yang valid",
+	"cidr":                          "{field} harus berupa notasi CIDR yang valid, bukan '{value}'",
+	"cidrv4":                        "{field} harus berupa notasi CIDR IPv4 yang valid, bukan '{value}'",
+	"cidrv6":                        "{field} harus berupa notasi CIDR IPv6 yang valid, bukan '{value}'",
+	"tcp_addr":                      "{field} harus berupa alamat TCP yang valid",
+	"tcp4_addr":                     "{field} harus berupa alamat TCPv4 yang valid",
+	"tcp6_addr":                     "{field} harus berupa alamat TCPv6 yang valid",
+	"udp_addr":                      "{field} harus berupa alamat UDP yang valid",
+	"udp4_addr":                     "{field} harus berupa alamat UDPv4 yang valid",
+	"udp6_addr":                     "{field} harus berupa alamat UDPv6 yang valid",
+	"ip_addr":                       "{field} harus berupa alamat IP yang bisa di-resolve",
+	"ip4_addr":                      "{field} harus berupa alamat IPv4 yang bisa di-resolve",
+	"ip6_addr":                      "{field} harus berupa alamat IPv6 yang bisa di-resolve",
+	"unix_addr":                     "{field} harus berupa alamat Unix yang valid",
+	"mac":                           "{field} harus berupa alamat MAC yang valid",
+	"hostname":                      "{field} harus berupa hostname yang valid",
+	"hostname_rfc1123":              "{field} harus berupa hostname RFC 1123 yang valid",
+	"hostname_port":                 "{field} harus berupa host dan port yang valid",
+	"fqdn":                          "{field} harus berupa nama domain lengkap (FQDN) yang valid",
+	"unique":                        "{field} harus berisi nilai yang unik",
+	"html":                          "{field} harus berupa HTML yang valid",
+	"html_encoded":                  "{field} harus berupa teks yang di-encode HTML",
+	"url_encoded":                   "{field} harus berupa teks yang di-encode URL",
+	"json":                          "{field} harus berupa JSON yang valid",
+	"jwt":                           "{field} harus berupa JWT yang valid",
+	"lowercase":                     "{field} harus berupa huruf kecil",
+	"uppercase":                     "{field} harus berupa huruf besar",
+	"datetime":                      "{field} harus berupa tanggal dan waktu dengan format {param}",
+	"timezone":                      "{field} harus berupa zona waktu yang valid",
+	"iso3166_1_alpha2":              "{field} harus berupa kode negara ISO 3166-1 alpha-2 yang valid",
+	"iso3166_1_alpha3":              "{field} harus berupa kode negara ISO 3166-1 alpha-3 yang valid",
+	"iso3166_1_alpha_numeric":       "{field} harus berupa kode negara ISO 3166-1 numerik yang valid",
+	"country_code":                  "{field} harus berupa kode negara ISO 3166-1 yang valid",
+	"iso3166_2":                     "{field} harus berupa kode wilayah ISO 3166-2 yang valid",
+	"iso4217":                       "{field} harus berupa kode mata uang ISO 4217 yang valid",
+	"iso4217_numeric":               "{field} harus berupa kode mata uang ISO 4217 numerik yang valid",
+	"bcp47_language_tag":            "{field} harus berupa tag bahasa BCP 47 yang valid",
+	"postcode_iso3166_alpha2":       "{field} harus berupa kode pos yang valid untuk negara {param}",
+	"postcode_iso3166_alpha2_field": "{field} harus berupa kode pos yang valid untuk negara di {param}",
+	"bic":                           "{field} harus berupa BIC yang valid",
+	"semver":                        "{field} harus berupa semantic version yang valid",
+	"dns_rfc1035_label":             "{field} harus berupa label DNS yang valid",
+	"credit_card":                   "{field} harus berupa nomor kartu kredit yang valid",
+	"cve":                           "{field} harus berupa identifier CVE yang valid",
+	"luhn_checksum":                 "{field} harus memiliki checksum Luhn yang valid",
+	"mongodb":                       "{field} harus berupa ObjectID MongoDB yang valid",
+	"cron":                          "{field} harus berupa ekspresi cron yang valid",
+	"spicedb":                       "{field} harus berupa identifier SpiceDB yang valid",
+	TagCategory:                     "{field} harus salah satu dari [{values}], bukan '{value}'",
+	TagMinCategory:                  "{field} minimal berisi {param} kategori dari [a b c d e]",
+	TagGender:                       "{field} harus salah satu dari [{values}], bukan '{value}'",
+	TagInSet:                        "{field} harus salah satu dari [{values}], bukan '{value}'",
+	TagType:                         "{field} harus berupa {param} yang valid, bukan '{value}'",
+	TagColumnCount:                  "baris harus berisi {param} kolom, bukan {value}",
+}
@@ -0,0 +1,458 @@
+package validation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"runtime"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// default konfigurasi ParallelValidator
+const (
+	DefaultParallelChunkSize = 256
+	DefaultParallelMinItems  = 1024
+)
+
+// validateTagPattern untuk mencari tag validate di dalam struct tag
+var validateTagPattern = regexp.MustCompile(`(^|\s)validate:"(?:[^"\\]|\\.)*"`)
+
+// crossFieldTags adalah tag bawaan yang membandingkan value dengan field lain dari struct yang berisi field tersebut
+// pada struct bayangan field lain itu tidak ada, jadi field yang tag nya (sebelum atau sesudah dive) berisi tag ini
+// divalidasi tanpa worker, contoh : "dive,ltefield=MaxScore" atau "required_with=Phone,dive"
+// tag di dalam item struct tetap bisa dibagi ke worker karena field pembandingnya ada di item itu sendiri
+var crossFieldTags = map[string]bool{
+	"eqfield": true, "nefield": true, "gtfield": true, "gtefield": true, "ltfield": true, "ltefield": true,
+	"eqcsfield": true, "necsfield": true, "gtcsfield": true, "gtecsfield": true, "ltcsfield": true, "ltecsfield": true,
+	"fieldcontains": true, "fieldexcludes": true,
+	"required_if": true, "required_unless": true, "required_with": true, "required_with_all": true,
+	"required_without": true, "required_without_all": true,
+	"excluded_if": true, "excluded_unless": true, "excluded_with": true, "excluded_with_all": true,
+	"excluded_without": true, "excluded_without_all": true,
+}
+
+// ParallelValidator untuk validasi struct dengan item dive (slice, array, map) dibagi ke beberapa worker
+// hanya field paling luar dengan tag dive dan jumlah item minimal MinItems yang dibagi ke worker,
+// field lain tetap divalidasi dengan validate.StructCtx
+// field yang tag nya membandingkan dengan field lain (misal eqfield atau required_if) juga divalidasi
+// dengan validate.StructCtx supaya hasilnya tetap sama
+// tag field diambil dari rules yang dipasang dengan WithRuleSet jika ada, rules yang didaftarkan langsung
+// dengan validate.RegisterStructValidationMapRules tidak terbaca
+//
+// urutan error sama persis dengan validate.StructCtx untuk slice dan array
+// untuk map urutan validator acak, di sini diurutkan berdasarkan key supaya hasilnya selalu sama
+// contoh : validation.NewParallelValidator(validate).StructCtx(ctx, user)
+type ParallelValidator struct {
+	// Validate adalah validator yang dipakai oleh setiap worker
+	Validate *validator.Validate
+
+	// Workers adalah jumlah worker maksimal, default runtime.GOMAXPROCS(0)
+	Workers int
+
+	// ChunkSize adalah jumlah item slice yang divalidasi oleh worker dalam satu kali kerja
+	ChunkSize int
+
+	// MinItems adalah jumlah item minimal supaya field divalidasi paralel
+	MinItems int
+}
+
+// NewParallelValidator untuk membuat ParallelValidator dengan konfigurasi default
+func NewParallelValidator(validate *validator.Validate) *ParallelValidator {
+	return &ParallelValidator{
+		Validate:  validate,
+		Workers:   runtime.GOMAXPROCS(0),
+		ChunkSize: DefaultParallelChunkSize,
+		MinItems:  DefaultParallelMinItems,
+	}
+}
+
+// parallelField adalah field dengan tag dive yang divalidasi paralel
+type parallelField struct {
+	index  int
+	field  reflect.StructField
+	value  reflect.Value
+	prefix string
+	dive   string
+}
+
+// StructCtx untuk validasi struct seperti validate.StructCtx dengan item dive dibagi ke beberapa worker
+// jika context dibatalkan, validasi berhenti dan error dari context yang dikembalikan
+func (p *ParallelValidator) StructCtx(ctx context.Context, value any) error {
+	if err := CheckStruct(p.Validate, value); err != nil {
+		return err
+	}
+
+	current := reflect.ValueOf(value)
+	if current.Kind() == reflect.Pointer && !current.IsNil() {
+		current = current.Elem()
+	}
+
+	if current.Kind() != reflect.Struct {
+		return p.Validate.StructCtx(ctx, value)
+	}
+
+	fields := p.parallelFields(current)
+	if len(fields) == 0 {
+		return p.Validate.StructCtx(ctx, value)
+	}
+
+	excluded := make([]string, 0, len(fields))
+	for _, field := range fields {
+		excluded = append(excluded, field.field.Name)
+	}
+
+	var validationErrors validator.ValidationErrors
+	if err := p.Validate.StructExceptCtx(ctx, value, excluded...); err != nil && !errors.As(err, &validationErrors) {
+		return err
+	}
+
+	root := ""
+	if name := current.Type().Name(); name != "" {
+		root = name + "."
+	}
+
+	order := make([]int, len(validationErrors))
+	for i, fieldError := range validationErrors {
+		order[i] = topFieldIndex(current.Type(), strings.TrimPrefix(fieldError.StructNamespace(), root))
+	}
+
+	for _, field := range fields {
+		fieldErrors, err := p.validateField(ctx, root, field)
+		if err != nil {
+			return err
+		}
+
+		for _, fieldError := range fieldErrors {
+			validationErrors = append(validationErrors, fieldError)
+			order = append(order, field.index)
+		}
+	}
+
+	if len(validationErrors) == 0 {
+		return nil
+	}
+
+	// validator memvalidasi field sesuai urutan deklarasi, jadi error diurutkan berdasarkan field paling luar
+	indexes := make([]int, len(validationErrors))
+	for i := range indexes {
+		indexes[i] = i
+	}
+	slices.SortStableFunc(indexes, func(a, b int) int {
+		return order[a] - order[b]
+	})
+
+	sorted := make(validator.ValidationErrors, 0, len(validationErrors))
+	for _, index := range indexes {
+		sorted = append(sorted, validationErrors[index])
+	}
+
+	return sorted
+}
+
+// parallelFields untuk mencari field paling luar dengan tag dive yang jumlah itemnya minimal MinItems
+func (p *ParallelValidator) parallelFields(current reflect.Value) []parallelField {
+	var fields []parallelField
+
+	for i := 0; i < current.NumField(); i++ {
+		field := current.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		tag := fieldTag(p.Validate, current.Type(), field)
+		prefix, dive, ok := splitDive(tag)
+		if !ok || hasCrossField(tag) {
+			continue
+		}
+
+		value := current.Field(i)
+		switch value.Kind() {
+		case reflect.Slice, reflect.Array, reflect.Map:
+			if value.Len() >= max(p.MinItems, 1) {
+				fields = append(fields, parallelField{index: i, field: field, value: value, prefix: prefix, dive: dive})
+			}
+		}
+	}
+
+	return fields
+}
+
+// validateField untuk validasi satu field, tag sebelum dive divalidasi sekali lalu item dibagi ke worker
+func (p *ParallelValidator) validateField(ctx context.Context, root string, field parallelField) ([]validator.FieldError, error) {
+	if field.prefix != "" {
+		fieldErrors, err := validateShadow(ctx, p.Validate, field.field, field.prefix, field.value)
+		if err != nil || len(fieldErrors) > 0 {
+			return rebase(fieldErrors, root, "", 0), err
+		}
+	}
+
+	if field.value.Kind() == reflect.Map {
+		return p.validateMap(ctx, root, field)
+	}
+
+	chunkSize := max(p.ChunkSize, 1)
+	chunks := (field.value.Len() + chunkSize - 1) / chunkSize
+	if field.value.Kind() == reflect.Array {
+		// array tidak bisa dipotong, ubah menjadi slice dengan item yang sama
+		slice := reflect.MakeSlice(reflect.SliceOf(field.value.Type().Elem()), field.value.Len(), field.value.Len())
+		reflect.Copy(slice, field.value)
+		field.value = slice
+	}
+
+	return p.run(ctx, chunks, func(chunk int) ([]validator.FieldError, error) {
+		start := chunk * chunkSize
+		end := min(start+chunkSize, field.value.Len())
+
+		fieldErrors, err := validateShadow(ctx, p.Validate, field.field, field.dive, field.value.Slice(start, end))
+		return rebase(fieldErrors, root, fieldAltPrefix(fieldErrors, field), start), err
+	})
+}
+
+// validateMap untuk validasi item map satu per satu sesuai urutan key
+func (p *ParallelValidator) validateMap(ctx context.Context, root string, field parallelField) ([]validator.FieldError, error) {
+	keys := field.value.MapKeys()
+	slices.SortFunc(keys, func(a, b reflect.Value) int {
+		return strings.Compare(fmt.Sprint(a.Interface()), fmt.Sprint(b.Interface()))
+	})
+
+	chunkSize := max(p.ChunkSize, 1)
+	chunks := (len(keys) + chunkSize - 1) / chunkSize
+
+	return p.run(ctx, chunks, func(chunk int) ([]validator.FieldError, error) {
+		start := chunk * chunkSize
+		end := min(start+chunkSize, len(keys))
+
+		// satu map berisi satu item dipakai ulang untuk setiap key supaya urutan error sesuai key
+		single := reflect.MakeMapWithSize(field.value.Type(), 1)
+
+		var fieldErrors []validator.FieldError
+		for _, key := range keys[start:end] {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+
+			single.SetMapIndex(key, field.value.MapIndex(key))
+			itemErrors, err := validateShadow(ctx, p.Validate, field.field, field.dive, single)
+			single.SetMapIndex(key, reflect.Value{})
+			if err != nil {
+				return nil, err
+			}
+
+			fieldErrors = append(fieldErrors, rebase(itemErrors, root, "", 0)...)
+		}
+
+		return fieldErrors, nil
+	})
+}
+
+// validateShadow untuk validasi value menggunakan struct yang hanya berisi satu field dengan tag yang diganti
+// nama field dan tag lain (misal json) tetap sama, jadi nama di error sama dengan hasil validate.StructCtx
+// juga dipakai oleh StructCtx untuk validasi item dive satu per satu
+func validateShadow(ctx context.Context, validate *validator.Validate, field reflect.StructField, tag string, value reflect.Value) ([]validator.FieldError, error) {
+	shadowTag := strings.TrimSpace(string(field.Tag) + " validate:" + strconv.Quote(tag))
+	if validateTagPattern.MatchString(string(field.Tag)) {
+		shadowTag = validateTagPattern.ReplaceAllString(string(field.Tag), "${1}validate:"+strconv.Quote(tag))
+	}
+
+	shadowType := reflect.StructOf([]reflect.StructField{{Name: field.Name, Type: value.Type(), Tag: reflect.StructTag(shadowTag)}})
+	shadow := reflect.New(shadowType).Elem()
+	shadow.Field(0).Set(value)
+
+	err := validate.StructCtx(ctx, shadow.Interface())
+
+	var validationErrors validator.ValidationErrors
+	if err != nil && !errors.As(err, &validationErrors) {
+		return nil, err
+	}
+
+	return validationErrors, nil
+}
+
+// run untuk menjalankan task di worker pool, hasil disusun sesuai urutan task
+// panic di worker diteruskan ke goroutine pemanggil seperti validasi tanpa worker
+func (p *ParallelValidator) run(ctx context.Context, tasks int, task func(index int) ([]validator.FieldError, error)) ([]validator.FieldError, error) {
+	results := make([][]validator.FieldError, tasks)
+	failures := make([]error, tasks)
+	panics := make([]any, tasks)
+
+	indexes := make(chan int)
+	var wait sync.WaitGroup
+
+	workers := min(max(p.Workers, 1), tasks)
+	for i := 0; i < workers; i++ {
+		wait.Add(1)
+		go func() {
+			defer wait.Done()
+
+			for index := range indexes {
+				func() {
+					defer func() {
+						panics[index] = recover()
+					}()
+
+					results[index], failures[index] = task(index)
+				}()
+			}
+		}()
+	}
+
+	// task tidak dikirim lagi setelah context dibatalkan
+sending:
+	for i := 0; i < tasks; i++ {
+		select {
+		case indexes <- i:
+		case <-ctx.Done():
+			break sending
+		}
+	}
+	close(indexes)
+	wait.Wait()
+
+	for _, recovered := range panics {
+		if recovered != nil {
+			panic(recovered)
+		}
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	var fieldErrors []validator.FieldError
+	for i, result := range results {
+		if failures[i] != nil {
+			return nil, failures[i]
+		}
+		fieldErrors = append(fieldErrors, result...)
+	}
+
+	return fieldErrors, nil
+}
+
+// splitDive untuk memisahkan tag sebelum dive dan tag mulai dari dive
+// contoh : "required,min=1,dive,required" menjadi "required,min=1" dan "dive,required"
+func splitDive(tag string) (string, string, bool) {
+	segments := strings.Split(tag, ",")
+
+	index := slices.Index(segments, "dive")
+	if index < 0 {
+		return "", "", false
+	}
+
+	return strings.Join(segments[:index], ","), strings.Join(segments[index:], ","), true
+}
+
+// hasCrossField untuk mengecek tag berisi salah satu crossFieldTags, termasuk di dalam pilihan dengan pipe
+func hasCrossField(tag string) bool {
+	for _, alternatives := range strings.Split(tag, ",") {
+		for _, single := range strings.Split(alternatives, "|") {
+			if name, _, _ := strings.Cut(single, "="); crossFieldTags[name] {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// topFieldIndex untuk mencari index field paling luar dari struct namespace tanpa nama struct
+// contoh : "Addresses[0].City" menjadi index field Addresses
+func topFieldIndex(structType reflect.Type, namespace string) int {
+	name := namespace
+	if end := strings.IndexAny(namespace, ".["); end >= 0 {
+		name = namespace[:end]
+	}
+
+	if field, ok := structType.FieldByName(name); ok && len(field.Index) > 0 {
+		return field.Index[0]
+	}
+
+	return structType.NumField()
+}
+
+// fieldAltPrefix untuk mengambil nama field di error, bisa berbeda dengan nama Go jika memakai WithFieldNameTag
+func fieldAltPrefix(fieldErrors []validator.FieldError, field parallelField) string {
+	for _, fieldError := range fieldErrors {
+		if name, _, ok := strings.Cut(fieldError.Namespace(), "["); ok {
+			return name
+		}
+	}
+
+	return field.field.Name
+}
+
+// rebase untuk menambahkan nama struct paling luar dan menggeser index item slice sebanyak offset
+func rebase(fieldErrors []validator.FieldError, root, altName string, offset int) []validator.FieldError {
+	rebased := make([]validator.FieldError, 0, len(fieldErrors))
+
+	for _, fieldError := range fieldErrors {
+		shifted := &rebasedFieldError{
+			FieldError:      fieldError,
+			namespace:       root + fieldError.Namespace(),
+			structNamespace: root + fieldError.StructNamespace(),
+			field:           fieldError.Field(),
+			structField:     fieldError.StructField(),
+		}
+
+		if altName != "" {
+			goName, _, _ := strings.Cut(fieldError.StructNamespace(), "[")
+
+			shifted.namespace = root + shiftIndex(fieldError.Namespace(), altName, offset)
+			shifted.structNamespace = root + shiftIndex(fieldError.StructNamespace(), goName, offset)
+			shifted.field = shiftIndex(fieldError.Field(), altName, offset)
+			shifted.structField = shiftIndex(fieldError.StructField(), goName, offset)
+		}
+
+		rebased = append(rebased, shifted)
+	}
+
+	return rebased
+}
+
+// shiftIndex untuk menambahkan offset ke index setelah prefix, contoh : "addresses[1].city" dengan offset 256
+// menjadi "addresses[257].city", value tanpa prefix dikembalikan apa adanya
+func shiftIndex(value, prefix string, offset int) string {
+	rest, ok := strings.CutPrefix(value, prefix+"[")
+	if !ok {
+		return value
+	}
+
+	index, rest, ok := strings.Cut(rest, "]")
+	if !ok {
+		return value
+	}
+
+	number, err := strconv.Atoi(index)
+	if err != nil {
+		return value
+	}
+
+	return prefix + "[" + strconv.Itoa(number+offset) + "]" + rest
+}
+
+// rebasedFieldError adalah error dari worker dengan namespace dan index yang sudah disesuaikan
+type rebasedFieldError struct {
+	validator.FieldError
+
+	namespace       string
+	structNamespace string
+	field           string
+	structField     string
+}
+
+func (e *rebasedFieldError) Namespace() string       { return e.namespace }
+func (e *rebasedFieldError) StructNamespace() string { return e.structNamespace }
+func (e *rebasedFieldError) Field() string           { return e.field }
+func (e *rebasedFieldError) StructField() string     { return e.structField }
+
+// Error sama dengan format error dari validator
+func (e *rebasedFieldError) Error() string {
+	return fmt.Sprintf("Key: '%s' Error:Field validation for '%s' failed on the '%s' tag", e.namespace, e.field, e.Tag())
+}
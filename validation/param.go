@@ -0,0 +1,270 @@
+package validation
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// ParamType untuk mengubah parameter tag (string) menjadi value dengan type T
+type ParamType[T any] struct {
+	Name  string
+	Parse func(param string) (T, error)
+}
+
+// type parameter bawaan
+var (
+	// IntParam untuk parameter angka, contoh : `validate:"min_category=2"`
+	IntParam = ParamType[int]{Name: "int", Parse: strconv.Atoi}
+
+	// DurationParam untuk parameter durasi, contoh : `validate:"max_age=24h"`
+	DurationParam = ParamType[time.Duration]{Name: "duration", Parse: time.ParseDuration}
+
+	// ListParam untuk parameter list yang dipisah spasi, contoh : `validate:"prefix=ID EN"`
+	ListParam = ParamType[[]string]{Name: "list", Parse: parseList}
+
+	// RegexParam untuk parameter regular expression, contoh : `validate:"pattern=^[A-Z]+$"`
+	RegexParam = ParamType[*regexp.Regexp]{Name: "regex", Parse: regexp.Compile}
+)
+
+// ParamFunc adalah function validasi yang menerima parameter yang sudah diubah ke type T
+type ParamFunc[T any] func(field validator.FieldLevel, param T) bool
+
+// paramRegistry berisi pengecekan parameter dari tag yang didaftarkan dengan WithParamValidation pada satu validator
+// beserta hasil CheckStruct per type, supaya type yang sama tidak dicek ulang setiap validasi
+type paramRegistry struct {
+	mutex  sync.RWMutex
+	checks map[string]func(param string) error
+	types  sync.Map
+}
+
+// paramRegistries berisi paramRegistry per validator
+// supaya tag dengan nama sama di validator lain (misal type parameter berbeda) tidak saling menimpa
+// validator lama dari RuleReloader dan validator sementara milik package ini dihapus melalui forgetValidator
+var (
+	paramRegistriesMutex sync.RWMutex
+	paramRegistries      = map[*validator.Validate]*paramRegistry{}
+)
+
+// paramResult adalah hasil parsing satu parameter yang disimpan di cache
+type paramResult[T any] struct {
+	value T
+	err   error
+}
+
+// ParamError adalah error untuk parameter tag yang tidak bisa diubah ke type nya
+type ParamError struct {
+	Tag   string
+	Param string
+	Type  string
+	Err   error
+}
+
+// Error untuk message dari ParamError
+func (e *ParamError) Error() string {
+	return fmt.Sprintf("invalid %s param %q for tag %s: %v", e.Type, e.Param, e.Tag, e.Err)
+}
+
+// Unwrap untuk mengambil error asli dari parsing parameter
+func (e *ParamError) Unwrap() error {
+	return e.Err
+}
+
+// WithParamValidation untuk mendaftarkan custom tag yang parameternya punya type
+// parameter diubah sekali untuk setiap value parameter yang berbeda lalu disimpan di cache
+// parameter yang tidak valid dikembalikan sebagai *ParamError oleh StructCtx, VarCtx, ParallelValidator,
+// StreamValidator, CSVValidator dan ValidateMap sebelum validasi dijalankan, bukan error validasi field
+// validate.StructCtx milik validator tidak bisa mengembalikan error tersebut, field dengan parameter
+// yang tidak valid hanya dianggap gagal, gunakan CheckStruct saat aplikasi mulai untuk mengecek lebih awal
+// contoh : validation.WithParamValidation("min_category", validation.IntParam, validation.ValidateMinCategory)
+func WithParamValidation[T any](tag string, paramType ParamType[T], fn ParamFunc[T], callValidationEvenIfNull ...bool) Option {
+	return func(validate *validator.Validate) error {
+		var cache sync.Map
+
+		parse := func(param string) paramResult[T] {
+			if cached, ok := cache.Load(param); ok {
+				return cached.(paramResult[T])
+			}
+
+			value, err := paramType.Parse(param)
+			result := paramResult[T]{value: value}
+			if err != nil {
+				result.err = &ParamError{Tag: tag, Param: param, Type: paramType.Name, Err: err}
+			}
+
+			cache.Store(param, result)
+			return result
+		}
+
+		err := validate.RegisterValidation(tag, func(field validator.FieldLevel) bool {
+			result := parse(field.Param())
+			if result.err != nil {
+				return false
+			}
+
+			return fn(field, result.value)
+		}, callValidationEvenIfNull...)
+		if err != nil {
+			return err
+		}
+
+		setParamCheck(validate, tag, func(param string) error {
+			return parse(param).err
+		})
+		return nil
+	}
+}
+
+// VarCtx untuk validasi variabel sama seperti validate.VarCtx
+// parameter dari tag yang didaftarkan dengan WithParamValidation dicek lebih dulu dan dikembalikan sebagai *ParamError
+// contoh : err := validation.VarCtx(ctx, validate, categories, "min_category=2")
+func VarCtx(ctx context.Context, validate *validator.Validate, value any, tag string) error {
+	if err := CheckTag(validate, tag); err != nil {
+		return err
+	}
+
+	return validate.VarCtx(ctx, value, tag)
+}
+
+// CheckTag untuk mengecek parameter dari setiap tag yang didaftarkan ke validate dengan WithParamValidation
+// contoh : validation.CheckTag(validate, "required,min_category=x") mengembalikan *ParamError
+func CheckTag(validate *validator.Validate, tag string) error {
+	registry := lookupParamRegistry(validate)
+	if registry == nil {
+		return nil
+	}
+
+	for _, alternatives := range strings.Split(tag, ",") {
+		for _, single := range strings.Split(alternatives, "|") {
+			name, param, _ := strings.Cut(single, "=")
+
+			registry.mutex.RLock()
+			check, ok := registry.checks[name]
+			registry.mutex.RUnlock()
+
+			if !ok {
+				continue
+			}
+
+			if err := check(param); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// CheckStruct untuk mengecek parameter tag validate dari semua field struct, termasuk struct di dalamnya
+// dipanggil saat aplikasi mulai supaya parameter yang salah ketahuan sebelum dipakai
+// hasil pengecekan disimpan per type, jadi juga dipanggil oleh StructCtx sebelum setiap validasi
+// contoh : validation.CheckStruct(validate, LoginRequest{})
+func CheckStruct(validate *validator.Validate, value any) error {
+	registry := lookupParamRegistry(validate)
+	structType := reflect.TypeOf(value)
+	if registry == nil || structType == nil {
+		return nil
+	}
+
+	if cached, ok := registry.types.Load(structType); ok {
+		err, _ := cached.(error)
+		return err
+	}
+
+	err := checkStructType(validate, structType, map[reflect.Type]bool{})
+	registry.types.Store(structType, err)
+	return err
+}
+
+// checkStructType untuk mengecek tag validate dari setiap field struct secara rekursif
+func checkStructType(validate *validator.Validate, structType reflect.Type, visited map[reflect.Type]bool) error {
+	for structType != nil && (structType.Kind() == reflect.Pointer || structType.Kind() == reflect.Slice ||
+		structType.Kind() == reflect.Array || structType.Kind() == reflect.Map) {
+		structType = structType.Elem()
+	}
+
+	if structType == nil || structType.Kind() != reflect.Struct || visited[structType] {
+		return nil
+	}
+	visited[structType] = true
+
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		if err := CheckTag(validate, field.Tag.Get("validate")); err != nil {
+			return fmt.Errorf("%s.%s: %w", structType.Name(), field.Name, err)
+		}
+
+		if err := checkStructType(validate, field.Type, visited); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// lookupParamRegistry untuk mengambil paramRegistry milik validator, nil jika tidak punya tag dengan parameter
+func lookupParamRegistry(validate *validator.Validate) *paramRegistry {
+	paramRegistriesMutex.RLock()
+	defer paramRegistriesMutex.RUnlock()
+
+	return paramRegistries[validate]
+}
+
+// setParamCheck untuk mengganti pengecekan parameter dari tag, check nil untuk menghapusnya
+// hasil CheckStruct yang sudah disimpan dibuang karena bisa berubah
+func setParamCheck(validate *validator.Validate, tag string, check func(param string) error) {
+	paramRegistriesMutex.Lock()
+	registry, ok := paramRegistries[validate]
+	if !ok && check != nil {
+		registry = &paramRegistry{checks: map[string]func(param string) error{}}
+		paramRegistries[validate] = registry
+	}
+	paramRegistriesMutex.Unlock()
+
+	if registry == nil {
+		return
+	}
+
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+
+	if check == nil {
+		delete(registry.checks, tag)
+	} else {
+		registry.checks[tag] = check
+	}
+
+	registry.types.Range(func(key, _ any) bool {
+		registry.types.Delete(key)
+		return true
+	})
+}
+
+// forgetParamChecks untuk menghapus pengecekan parameter dari validator yang tidak dipakai lagi
+func forgetParamChecks(validate *validator.Validate) {
+	paramRegistriesMutex.Lock()
+	defer paramRegistriesMutex.Unlock()
+
+	delete(paramRegistries, validate)
+}
+
+// parseList untuk memisahkan parameter list dengan spasi
+func parseList(param string) ([]string, error) {
+	values := strings.Fields(param)
+	if len(values) == 0 {
+		return nil, fmt.Errorf("list must have at least one value")
+	}
+
+	return values, nil
+}
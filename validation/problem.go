@@ -0,0 +1,289 @@
+package validation
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// ProblemContentType adalah content type untuk response problem details (RFC 7807)
+const ProblemContentType = "application/problem+json"
+
+// InvalidParam adalah detail error per field di dalam Problem
+// Pointer berisi JSON pointer (RFC 6901) ke field yang error, misal "/addresses/0/city"
+type InvalidParam struct {
+	Name       string `json:"name"`
+	Reason     string `json:"reason"`
+	Code       string `json:"code"`
+	Pointer    string `json:"pointer"`
+	Suggestion string `json:"suggestion,omitempty"`
+}
+
+// Problem adalah dokumen application/problem+json (RFC 7807)
+type Problem struct {
+	Type          string         `json:"type"`
+	Title         string         `json:"title"`
+	Status        int            `json:"status"`
+	Detail        string         `json:"detail,omitempty"`
+	Instance      string         `json:"instance,omitempty"`
+	InvalidParams []InvalidParam `json:"invalid-params,omitempty"`
+}
+
+// NewProblem untuk mengubah error dari validasi atau DecodeJSON menjadi Problem
+// error validasi menghasilkan status 422 dengan invalid-params berisi setiap field yang error
+// pointer dibuat dari Path, sehingga nama field sama dengan nama di error validator,
+// pakai validation.WithFieldNameTag("json") atau NewProblemFor agar pointer memakai nama dari tag json
+func NewProblem(err error, catalog *Catalog, locale string) Problem {
+	return NewProblemFor(nil, err, catalog, locale)
+}
+
+// NewProblemFor sama seperti NewProblem tetapi pointer dibuat dari value yang divalidasi
+// nama field diambil dari tag json dan key map diambil dari map di value, bukan dari namespace error
+// jika value nil atau path tidak ditemukan di value, pointer dibuat dari Path seperti NewProblem
+// contoh : problem := validation.NewProblemFor(user, validate.StructCtx(ctx, user), catalog, "id")
+func NewProblemFor(value any, err error, catalog *Catalog, locale string) Problem {
+	status, detail := errorStatus(err)
+
+	problem := Problem{
+		Type:   "about:blank",
+		Title:  http.StatusText(status),
+		Status: status,
+		Detail: detail,
+	}
+
+	var validationErrors validator.ValidationErrors
+	if errors.As(err, &validationErrors) {
+		problem.InvalidParams = make([]InvalidParam, 0, len(validationErrors))
+		for _, fieldError := range validationErrors {
+			problem.InvalidParams = append(problem.InvalidParams, InvalidParam{
+				Name:       Path(fieldError),
+				Reason:     catalog.Translate(fieldError, locale),
+				Code:       Code(fieldError),
+				Pointer:    valuePointer(value, fieldError),
+				Suggestion: catalog.Suggestion(fieldError),
+			})
+		}
+	}
+
+	return problem
+}
+
+// WriteProblem untuk menulis Problem sebagai response dengan content type application/problem+json
+func WriteProblem(w http.ResponseWriter, problem Problem) error {
+	w.Header().Set("Content-Type", ProblemContentType)
+	w.WriteHeader(problem.Status)
+	return json.NewEncoder(w).Encode(problem)
+}
+
+// problemValueKey adalah key context untuk value hasil decode yang gagal divalidasi, dipakai oleh problemErrorHandler
+type problemValueKey struct{}
+
+// problemErrorHandler adalah error handler yang menulis response dalam format problem details
+// instance diisi dengan path dari request
+func problemErrorHandler(catalog *Catalog) ErrorHandler {
+	return func(w http.ResponseWriter, r *http.Request, err error) {
+		problem := NewProblemFor(r.Context().Value(problemValueKey{}), err, catalog, catalog.requestLocale(r))
+		problem.Instance = r.URL.Path
+
+		_ = WriteProblem(w, problem)
+	}
+}
+
+// Pointer untuk mengubah Path dari error menjadi JSON pointer (RFC 6901)
+// contoh : "addresses[0].city" menjadi "/addresses/0/city" dan "[user1]" menjadi "/user1"
+func Pointer(fieldError validator.FieldError) string {
+	return joinPointer(pathSegments(Path(fieldError)))
+}
+
+// joinPointer untuk menggabungkan segment menjadi JSON pointer dengan escape ~ dan /
+func joinPointer(segments []string) string {
+	var pointer strings.Builder
+	for _, segment := range segments {
+		pointer.WriteString("/")
+		pointer.WriteString(strings.NewReplacer("~", "~0", "/", "~1").Replace(segment))
+	}
+
+	return pointer.String()
+}
+
+// pathSegments untuk memecah path seperti "addresses[0].city" menjadi ["addresses", "0", "city"]
+// isi kurung siku boleh berisi titik atau kurung siku, misal key map "reo.work",
+// kurung siku ditutup oleh "]" yang diikuti titik, kurung siku baru atau akhir path
+func pathSegments(path string) []string {
+	var segments []string
+	for path != "" {
+		if path[0] == '[' {
+			end := closingBracket(path)
+			segments = append(segments, path[1:end])
+			path = path[min(end+1, len(path)):]
+			continue
+		}
+
+		path = strings.TrimPrefix(path, ".")
+		end := strings.IndexAny(path, ".[")
+		if end == -1 {
+			end = len(path)
+		}
+
+		if end > 0 {
+			segments = append(segments, path[:end])
+		}
+		path = path[end:]
+	}
+
+	return segments
+}
+
+// closingBracket untuk mencari "]" yang menutup "[" di awal path
+func closingBracket(path string) int {
+	for i := 1; i < len(path); i++ {
+		if path[i] == ']' && (i+1 == len(path) || path[i+1] == '.' || path[i+1] == '[') {
+			return i
+		}
+	}
+
+	return len(path)
+}
+
+// valuePointer untuk membuat JSON pointer dari segment asli di value, jika gagal memakai Pointer
+func valuePointer(value any, fieldError validator.FieldError) string {
+	if value != nil {
+		if segments, ok := valueSegments(reflect.ValueOf(value), fieldError.StructNamespace()); ok {
+			return joinPointer(segments)
+		}
+	}
+
+	return Pointer(fieldError)
+}
+
+// valueSegments untuk menelusuri value mengikuti StructNamespace dari error
+// field struct memakai nama dari tag json, index dan key map dicocokkan dengan isi value
+// sehingga key map yang berisi titik atau kurung siku tetap menjadi satu segment
+func valueSegments(current reflect.Value, namespace string) ([]string, bool) {
+	current = indirect(current)
+	if !current.IsValid() {
+		return nil, false
+	}
+
+	// namespace diawali nama type paling luar, kecuali type tanpa nama dan validasi variabel
+	switch {
+	case current.Kind() == reflect.Struct && current.Type().Name() != "":
+		namespace = strings.TrimPrefix(namespace, current.Type().Name()+".")
+	case current.Kind() == reflect.Map:
+		namespace = strings.TrimPrefix(namespace, MapNamespace+".")
+	}
+
+	var segments []string
+	for rest := namespace; rest != ""; {
+		current = indirect(current)
+		if !current.IsValid() {
+			return nil, false
+		}
+
+		var segment string
+		var ok bool
+		if rest[0] == '[' {
+			segment, current, rest, ok = indexSegment(current, rest)
+		} else {
+			segment, current, rest, ok = nameSegment(current, strings.TrimPrefix(rest, "."))
+		}
+
+		if !ok {
+			return nil, false
+		}
+		segments = append(segments, segment)
+	}
+
+	return segments, true
+}
+
+// indexSegment untuk mengambil index slice atau key map dari awal rest, misal "[0]" atau "[reo.work]"
+func indexSegment(current reflect.Value, rest string) (string, reflect.Value, string, bool) {
+	switch current.Kind() {
+	case reflect.Slice, reflect.Array:
+		index, after, found := strings.Cut(rest[1:], "]")
+		position, err := strconv.Atoi(index)
+		if !found || err != nil || position < 0 || position >= current.Len() {
+			return "", current, rest, false
+		}
+		return index, current.Index(position), after, true
+	case reflect.Map:
+		return mapSegment(current, rest, "[", "]")
+	default:
+		return "", current, rest, false
+	}
+}
+
+// nameSegment untuk mengambil nama field struct atau key map dari awal rest, misal "Address.City"
+func nameSegment(current reflect.Value, rest string) (string, reflect.Value, string, bool) {
+	switch current.Kind() {
+	case reflect.Struct:
+		end := strings.IndexAny(rest, ".[")
+		if end == -1 {
+			end = len(rest)
+		}
+
+		field, ok := current.Type().FieldByName(rest[:end])
+		if !ok {
+			return "", current, rest, false
+		}
+
+		name := fieldName(field, []string{"json"})
+		if name == "" {
+			name = field.Name
+		}
+		return name, current.FieldByIndex(field.Index), rest[end:], true
+	case reflect.Map:
+		// map dari ValidateMap menulis key sebagai nama field, misal "map.address.city"
+		return mapSegment(current, rest, "", "")
+	default:
+		return "", current, rest, false
+	}
+}
+
+// mapSegment untuk mencari key map yang ditulis di awal rest diapit prefix dan suffix
+// jika beberapa key cocok, dipilih key terpanjang yang diikuti titik, kurung siku atau akhir path
+func mapSegment(current reflect.Value, rest, prefix, suffix string) (string, reflect.Value, string, bool) {
+	var key reflect.Value
+	var segment string
+	length := -1
+
+	iterator := current.MapRange()
+	for iterator.Next() {
+		text := fmt.Sprint(iterator.Key().Interface())
+		written := prefix + text + suffix
+		if !strings.HasPrefix(rest, written) || len(written) <= length {
+			continue
+		}
+
+		if after := rest[len(written):]; after != "" && after[0] != '.' && after[0] != '[' {
+			continue
+		}
+
+		key, segment, length = iterator.Key(), text, len(written)
+	}
+
+	if length == -1 {
+		return "", current, rest, false
+	}
+
+	return segment, current.MapIndex(key), rest[length:], true
+}
+
+// indirect untuk mengambil isi pointer dan interface, pointer nil menghasilkan reflect.Value kosong
+func indirect(value reflect.Value) reflect.Value {
+	for value.Kind() == reflect.Pointer || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return reflect.Value{}
+		}
+		value = value.Elem()
+	}
+
+	return value
+}
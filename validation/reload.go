@@ -0,0 +1,192 @@
+package validation
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// DefaultReloadInterval adalah jarak waktu pengecekan file rules oleh Watch
+const DefaultReloadInterval = 5 * time.Second
+
+// RuleVersion adalah informasi versi rules yang sedang aktif
+type RuleVersion struct {
+	Number   int       `json:"number"`
+	Checksum string    `json:"checksum"`
+	LoadedAt time.Time `json:"loaded_at"`
+}
+
+// ruleState adalah validator yang sudah berisi rules dari satu versi file
+type ruleState struct {
+	validate *validator.Validate
+	version  RuleVersion
+}
+
+// RuleReloader untuk validator bersama yang rules nya dibaca dari file dan bisa diganti saat aplikasi berjalan
+// validator diganti secara atomic, jadi aman dipakai oleh banyak goroutine sekaligus
+// jika file yang baru tidak valid, versi terakhir yang valid tetap dipakai
+type RuleReloader struct {
+	path    string
+	loader  RuleLoader
+	options []Option
+
+	current atomic.Pointer[ruleState]
+	mutex   sync.Mutex
+	lastErr error
+	stat    os.FileInfo
+
+	// retired adalah validator yang diganti oleh reload terakhir, mungkin masih dipakai oleh validasi yang sedang berjalan
+	retired *validator.Validate
+
+	// OnReload dipanggil setiap selesai reload, err berisi alasan jika file baru ditolak
+	OnReload func(version RuleVersion, err error)
+}
+
+// NewRuleReloader untuk membuat validator dari file rules, options dipasang ke setiap validator yang dibuat
+// file pertama harus valid, jika tidak error dikembalikan
+func NewRuleReloader(path string, loader RuleLoader, options ...Option) (*RuleReloader, error) {
+	reloader := &RuleReloader{path: path, loader: loader, options: options}
+	if err := reloader.Reload(); err != nil {
+		return nil, err
+	}
+
+	return reloader, nil
+}
+
+// Validator untuk mengambil validator dari versi rules yang sedang aktif
+func (r *RuleReloader) Validator() *validator.Validate {
+	return r.current.Load().validate
+}
+
+// Version untuk mengambil versi rules yang sedang aktif
+func (r *RuleReloader) Version() RuleVersion {
+	return r.current.Load().version
+}
+
+// LastError untuk mengambil error dari reload terakhir, nil jika reload terakhir berhasil
+func (r *RuleReloader) LastError() error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.lastErr
+}
+
+// StructCtx untuk validasi struct menggunakan validator dari versi rules yang sedang aktif
+// sama seperti StructCtx milik package ini, parameter tag yang tidak valid dikembalikan sebagai *ParamError
+func (r *RuleReloader) StructCtx(ctx context.Context, value any) error {
+	return StructCtx(ctx, r.Validator(), value)
+}
+
+// Reload untuk membaca ulang file rules dan mengganti validator jika isi file berubah
+// jika file tidak valid, validator yang lama tetap dipakai dan error dikembalikan
+func (r *RuleReloader) Reload() error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	version, err := r.reload()
+	r.lastErr = err
+	if r.OnReload != nil && r.current.Load() != nil {
+		r.OnReload(version, err)
+	}
+
+	return err
+}
+
+// reload untuk membuat validator baru dari isi file, harus dipanggil dengan mutex terkunci
+func (r *RuleReloader) reload() (RuleVersion, error) {
+	current := r.current.Load()
+
+	stat, err := os.Stat(r.path)
+	if err != nil {
+		return r.activeVersion(current), err
+	}
+
+	content, err := os.ReadFile(r.path)
+	if err != nil {
+		return r.activeVersion(current), err
+	}
+
+	// stat disimpan walaupun isi file ditolak supaya file yang sama tidak dibaca ulang oleh Watch
+	r.stat = stat
+
+	sum := sha256.Sum256(content)
+	checksum := hex.EncodeToString(sum[:])
+	if current != nil && current.version.Checksum == checksum {
+		return current.version, nil
+	}
+
+	set, err := r.loader.Parse(r.path, content)
+	if err != nil {
+		return r.activeVersion(current), err
+	}
+
+	options := append(append([]Option{}, r.options...), WithRuleSet(set))
+	validate, err := New(options...)
+	if err != nil {
+		return r.activeVersion(current), err
+	}
+
+	version := RuleVersion{Number: 1, Checksum: checksum, LoadedAt: time.Now()}
+	if current != nil {
+		version.Number = current.version.Number + 1
+	}
+
+	r.current.Store(&ruleState{validate: validate, version: version})
+
+	// pengecekan parameter dan rules dari validator sebelum validator lama dihapus supaya setiap reload tidak menumpuk
+	// validator lama disimpan satu reload lagi karena validasi yang sedang berjalan mungkin masih memakainya
+	if r.retired != nil {
+		forgetValidator(r.retired)
+	}
+	if current != nil {
+		r.retired = current.validate
+	}
+	return version, nil
+}
+
+// activeVersion untuk mengambil versi yang sedang aktif, kosong jika belum ada
+func (r *RuleReloader) activeVersion(current *ruleState) RuleVersion {
+	if current == nil {
+		return RuleVersion{}
+	}
+	return current.version
+}
+
+// changed untuk mengecek apakah waktu ubah atau ukuran file berbeda dari saat terakhir dibaca
+func (r *RuleReloader) changed() bool {
+	stat, err := os.Stat(r.path)
+	if err != nil {
+		return true
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.stat == nil || !stat.ModTime().Equal(r.stat.ModTime()) || stat.Size() != r.stat.Size()
+}
+
+// Watch untuk mengecek file rules setiap interval dan reload jika file berubah, berhenti saat ctx selesai
+// contoh : go reloader.Watch(ctx, validation.DefaultReloadInterval)
+func (r *RuleReloader) Watch(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		interval = DefaultReloadInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if r.changed() {
+				_ = r.Reload()
+			}
+		}
+	}
+}
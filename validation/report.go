@@ -0,0 +1,109 @@
+package validation
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// ReportFormat adalah format output dari ReportWriter
+type ReportFormat string
+
+// format report yang didukung
+const (
+	ReportCSV  ReportFormat = "csv"
+	ReportJSON ReportFormat = "json"
+	ReportText ReportFormat = "text"
+)
+
+// ReportWriter untuk menulis CSVIssue satu per satu ke output
+// Close harus dipanggil setelah issue terakhir supaya output lengkap, misal penutup array JSON
+type ReportWriter interface {
+	WriteIssue(issue CSVIssue) error
+	Close() error
+}
+
+// NewReportWriter untuk membuat ReportWriter sesuai format
+// contoh : report, err := validation.NewReportWriter(os.Stdout, validation.ReportJSON)
+func NewReportWriter(writer io.Writer, format ReportFormat) (ReportWriter, error) {
+	switch format {
+	case ReportCSV:
+		csvWriter := csv.NewWriter(writer)
+		return &csvReport{writer: csvWriter}, csvWriter.Write([]string{"row", "column", "tag", "code", "message"})
+	case ReportJSON:
+		return &jsonReport{writer: writer}, nil
+	case ReportText:
+		return &textReport{writer: writer}, nil
+	default:
+		return nil, fmt.Errorf("unknown report format %q", format)
+	}
+}
+
+// csvReport menulis report sebagai CSV dengan header row,column,tag,code,message
+type csvReport struct {
+	writer *csv.Writer
+}
+
+func (r *csvReport) WriteIssue(issue CSVIssue) error {
+	return r.writer.Write([]string{strconv.Itoa(issue.Row), issue.Column, issue.Tag, issue.Code, issue.Message})
+}
+
+func (r *csvReport) Close() error {
+	r.writer.Flush()
+	return r.writer.Error()
+}
+
+// jsonReport menulis report sebagai satu JSON array, setiap issue ditulis langsung tanpa ditampung
+type jsonReport struct {
+	writer io.Writer
+	count  int
+}
+
+func (r *jsonReport) WriteIssue(issue CSVIssue) error {
+	content, err := json.Marshal(issue)
+	if err != nil {
+		return err
+	}
+
+	separator := ",\n  "
+	if r.count == 0 {
+		separator = "[\n  "
+	}
+	r.count++
+
+	_, err = fmt.Fprintf(r.writer, "%s%s", separator, content)
+	return err
+}
+
+func (r *jsonReport) Close() error {
+	closing := "\n]\n"
+	if r.count == 0 {
+		closing = "[]\n"
+	}
+
+	_, err := io.WriteString(r.writer, closing)
+	return err
+}
+
+// textReport menulis report satu baris per issue dengan error code di akhir
+// contoh : row 3, column email (email): email must be a valid email address [INVALID_EMAIL]
+// issue tanpa kolom, contoh : row 4 (column_count): row must have 7 columns, got 2 [INVALID_COLUMN_COUNT]
+type textReport struct {
+	writer io.Writer
+}
+
+func (r *textReport) WriteIssue(issue CSVIssue) error {
+	location := fmt.Sprintf("row %d", issue.Row)
+	if issue.Column != "" {
+		location += ", column " + issue.Column
+	}
+
+	_, err := fmt.Fprintf(r.writer, "%s (%s): %s [%s]\n", location, issue.Tag, issue.Message, issue.Code)
+	return err
+}
+
+func (r *textReport) Close() error {
+	return nil
+}
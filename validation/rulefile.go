@@ -0,0 +1,438 @@
+package validation
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"gopkg.in/yaml.v3"
+)
+
+// RuleTypes memetakan nama type di file rules ke value dari struct nya
+// contoh : validation.RuleTypes{"LoginRequest": LoginRequest{}}
+type RuleTypes map[string]any
+
+// RuleMode menentukan cara rules dari file digabung dengan tag validate pada struct
+type RuleMode int
+
+const (
+	// RuleOverride untuk mengganti tag validate pada field dengan tag dari file
+	RuleOverride RuleMode = iota
+
+	// RuleMerge untuk menambahkan tag dari file di belakang tag validate pada field
+	// jika tag pada field berisi dive, tag dari file disisipkan sebelum dive supaya tetap berlaku untuk field nya
+	// bukan untuk setiap elemen, contoh : "required,dive,email" + "max=5" menjadi "required,max=5,dive,email"
+	RuleMerge
+)
+
+// KeyAliases adalah key khusus di file rules untuk mengganti atau menambah alias tag
+// contoh : _aliases: {app_email: "required,email,min=20"}
+const KeyAliases = "_aliases"
+
+// numericParamTags adalah tag bawaan yang parameternya harus berupa angka atau durasi
+var numericParamTags = map[string]bool{
+	"min": true, "max": true, "len": true, "eq": true, "ne": true,
+	"gt": true, "gte": true, "lt": true, "lte": true,
+}
+
+// valueParamTags adalah tag yang parameternya hanya harus angka jika field nya angka
+// eq dan ne pada string membandingkan teks, misal eq=active
+var valueParamTags = map[string]bool{
+	"eq": true, "ne": true,
+}
+
+// RuleLoader untuk membaca rules dari file YAML atau JSON dengan format type -> field -> tag
+// field boleh ditulis dengan nama field Go atau nama dari tag json
+//
+// contoh isi file :
+//
+//	_aliases:
+//	  app_email: required,email,min=20
+//	LoginRequest:
+//	  Password: required,min=8
+//	  username: required,email
+type RuleLoader struct {
+	Types RuleTypes
+	Mode  RuleMode
+
+	// Options adalah option tambahan untuk validator yang dipakai mengecek tag, misal custom tag lain
+	Options []Option
+}
+
+// RuleSet adalah rules dari file yang sudah dicek, dipasang ke validator menggunakan WithRuleSet
+type RuleSet struct {
+	rules   map[reflect.Type]map[string]string
+	aliases map[string]string
+}
+
+// validatorRules berisi rules per type yang dipasang ke setiap validator dengan WithRuleSet
+// validator tidak membuka rules yang sudah didaftarkan, jadi ParallelValidator dan StructCtx membaca tag field dari sini
+// validator lama dari RuleReloader dan validator sementara milik package ini dihapus melalui forgetValidator
+var (
+	validatorRulesMutex sync.RWMutex
+	validatorRules      = map[*validator.Validate]map[reflect.Type]map[string]string{}
+)
+
+// Load untuk membaca dan mengecek rules dari file
+func (l RuleLoader) Load(path string) (*RuleSet, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return l.Parse(path, content)
+}
+
+// Parse untuk membaca dan mengecek rules dari content, name dipakai sebagai nama file di pesan error
+// tag yang tidak dikenal atau parameter yang tidak valid (misal min=abc) ditolak beserta posisi baris dan kolomnya
+func (l RuleLoader) Parse(name string, content []byte) (*RuleSet, error) {
+	var document yaml.Node
+	if err := yaml.Unmarshal(content, &document); err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+
+	set := &RuleSet{rules: make(map[reflect.Type]map[string]string), aliases: make(map[string]string)}
+	if len(document.Content) == 0 {
+		return set, nil
+	}
+
+	probe, err := New(l.Options...)
+	if err != nil {
+		return nil, err
+	}
+	defer forgetValidator(probe)
+
+	root := document.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return nil, positionError(name, root, "rules file must be an object of type -> field -> tag")
+	}
+
+	// alias dipasang lebih dulu ke probe supaya bisa dipakai oleh rules type di bawahnya
+	for i := 0; i < len(root.Content); i += 2 {
+		if root.Content[i].Value == KeyAliases {
+			if err := l.parseAliases(name, probe, root.Content[i+1], set); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	for i := 0; i < len(root.Content); i += 2 {
+		typeNode, fieldsNode := root.Content[i], root.Content[i+1]
+		if typeNode.Value == KeyAliases {
+			continue
+		}
+
+		sample, ok := l.Types[typeNode.Value]
+		if !ok {
+			return nil, positionError(name, typeNode, "type %q is not registered", typeNode.Value)
+		}
+
+		structType := reflect.TypeOf(sample)
+		for structType != nil && structType.Kind() == reflect.Pointer {
+			structType = structType.Elem()
+		}
+		if structType == nil || structType.Kind() != reflect.Struct {
+			return nil, positionError(name, typeNode, "type %q must be a struct", typeNode.Value)
+		}
+
+		if fieldsNode.Kind != yaml.MappingNode {
+			return nil, positionError(name, fieldsNode, "rules of type %q must be an object of field -> tag", typeNode.Value)
+		}
+
+		rules := set.rules[structType]
+		if rules == nil {
+			rules = make(map[string]string)
+			set.rules[structType] = rules
+		}
+
+		for j := 0; j < len(fieldsNode.Content); j += 2 {
+			fieldNode, tagNode := fieldsNode.Content[j], fieldsNode.Content[j+1]
+
+			field, ok := lookupField(structType, fieldNode.Value)
+			if !ok {
+				return nil, positionError(name, fieldNode, "type %q has no field %q", typeNode.Value, fieldNode.Value)
+			}
+
+			if tagNode.Kind != yaml.ScalarNode {
+				return nil, positionError(name, tagNode, "tag of %s.%s must be a string", typeNode.Value, field.Name)
+			}
+
+			tag := tagNode.Value
+			if existing := field.Tag.Get("validate"); l.Mode == RuleMerge && existing != "" && existing != "-" {
+				tag = mergeTag(existing, tag)
+			}
+
+			if err := checkTag(probe, tag, field.Type, set.aliases); err != nil {
+				return nil, positionError(name, tagNode, "%s.%s: %v", typeNode.Value, field.Name, err)
+			}
+
+			rules[field.Name] = tag
+		}
+	}
+
+	return set, nil
+}
+
+// parseAliases untuk membaca bagian _aliases lalu memasangnya ke probe
+func (l RuleLoader) parseAliases(name string, probe *validator.Validate, node *yaml.Node, set *RuleSet) error {
+	if node.Kind != yaml.MappingNode {
+		return positionError(name, node, "%s must be an object of alias -> tags", KeyAliases)
+	}
+
+	for i := 0; i < len(node.Content); i += 2 {
+		aliasNode, tagNode := node.Content[i], node.Content[i+1]
+		if tagNode.Kind != yaml.ScalarNode {
+			return positionError(name, tagNode, "tags of alias %s must be a string", aliasNode.Value)
+		}
+
+		// type field belum diketahui, parameter angka dicek lagi dengan type field saat alias dipakai
+		if err := checkTag(probe, tagNode.Value, nil, set.aliases); err != nil {
+			return positionError(name, tagNode, "alias %s: %v", aliasNode.Value, err)
+		}
+
+		if err := WithAlias(aliasNode.Value, tagNode.Value)(probe); err != nil {
+			return positionError(name, aliasNode, "alias %s: %v", aliasNode.Value, err)
+		}
+
+		set.aliases[aliasNode.Value] = tagNode.Value
+	}
+
+	return nil
+}
+
+// WithRuleSet untuk memasang rules dari file ke validator
+// harus dipasang saat validator dibuat, sebelum struct pertama kali divalidasi
+func WithRuleSet(set *RuleSet) Option {
+	return func(validate *validator.Validate) error {
+		for alias, tags := range set.aliases {
+			if err := WithAlias(alias, tags)(validate); err != nil {
+				return err
+			}
+		}
+
+		validatorRulesMutex.Lock()
+		defer validatorRulesMutex.Unlock()
+
+		if validatorRules[validate] == nil {
+			validatorRules[validate] = map[reflect.Type]map[string]string{}
+		}
+
+		// sama seperti validator, rules untuk type yang sudah ada diganti semuanya
+		for structType, rules := range set.rules {
+			validate.RegisterStructValidationMapRules(rules, reflect.New(structType).Elem().Interface())
+			validatorRules[validate][structType] = rules
+		}
+		return nil
+	}
+}
+
+// fieldTag untuk mengambil tag validasi dari field, tag dari rules yang dipasang dengan WithRuleSet didahulukan
+// rules yang didaftarkan langsung dengan validate.RegisterStructValidationMapRules tidak terbaca
+func fieldTag(validate *validator.Validate, structType reflect.Type, field reflect.StructField) string {
+	validatorRulesMutex.RLock()
+	tag, ok := validatorRules[validate][structType][field.Name]
+	validatorRulesMutex.RUnlock()
+
+	if ok {
+		return tag
+	}
+
+	return field.Tag.Get("validate")
+}
+
+// forgetRules untuk menghapus rules dari validator yang tidak dipakai lagi
+func forgetRules(validate *validator.Validate) {
+	validatorRulesMutex.Lock()
+	defer validatorRulesMutex.Unlock()
+
+	delete(validatorRules, validate)
+}
+
+// WithRulesFile untuk membaca rules dari file lalu memasangnya ke validator
+// contoh : validation.New(validation.WithRulesFile("rules.yaml", validation.RuleLoader{Types: types}))
+func WithRulesFile(path string, loader RuleLoader) Option {
+	return func(validate *validator.Validate) error {
+		set, err := loader.Load(path)
+		if err != nil {
+			return err
+		}
+		return WithRuleSet(set)(validate)
+	}
+}
+
+// Tag untuk mengambil tag dari field yang diatur oleh rule set
+func (s *RuleSet) Tag(value any, field string) (string, bool) {
+	structType := reflect.TypeOf(value)
+	for structType != nil && structType.Kind() == reflect.Pointer {
+		structType = structType.Elem()
+	}
+
+	tag, ok := s.rules[structType][field]
+	return tag, ok
+}
+
+// lookupField untuk mencari field dari nama field Go atau nama dari tag json
+func lookupField(structType reflect.Type, name string) (reflect.StructField, bool) {
+	if field, ok := structType.FieldByName(name); ok && field.IsExported() {
+		return field, true
+	}
+
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		if jsonName, _, _ := strings.Cut(field.Tag.Get("json"), ","); jsonName == name && field.IsExported() {
+			return field, true
+		}
+	}
+
+	return reflect.StructField{}, false
+}
+
+// mergeTag untuk menggabungkan tag dari file ke tag pada field, tag dari file disisipkan sebelum dive pertama
+func mergeTag(existing, tag string) string {
+	tags := strings.Split(existing, ",")
+	for i, single := range tags {
+		if single == "dive" {
+			return strings.Join(append(append(tags[:i:i], tag), tags[i:]...), ",")
+		}
+	}
+
+	return existing + "," + tag
+}
+
+// checkTag untuk mengecek tag tanpa menjalankan validasi
+// tag yang tidak dikenal dicek dengan parsing tag oleh validator, parameter angka dicek satu per satu
+// sesuai type yang divalidasi, setelah dive type nya adalah type elemen dan di antara keys dan endkeys type key map
+func checkTag(probe *validator.Validate, tag string, fieldType reflect.Type, aliases map[string]string) (err error) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			err = fmt.Errorf("invalid tag %q: %v", tag, recovered)
+		}
+	}()
+
+	// value nil membuat validator hanya melakukan parsing tag tanpa menjalankan function validasinya
+	_ = probe.VarCtx(context.Background(), nil, tag)
+
+	checker := &paramChecker{current: fieldType, aliases: aliases, expanding: map[string]bool{}}
+	if err := checker.check(tag); err != nil {
+		return err
+	}
+
+	return CheckTag(probe, tag)
+}
+
+// paramChecker untuk mengecek parameter angka sambil mengikuti type yang divalidasi oleh setiap tag
+type paramChecker struct {
+	current   reflect.Type
+	container reflect.Type
+	aliases   map[string]string
+	expanding map[string]bool
+}
+
+// check untuk mengecek setiap tag, alias diperiksa dengan isi alias nya memakai type saat itu
+func (c *paramChecker) check(tag string) error {
+	for _, alternatives := range strings.Split(tag, ",") {
+		switch alternatives {
+		case "dive":
+			c.container = indirectType(c.current)
+			c.current = elemType(c.container)
+			continue
+		case "keys":
+			if c.container != nil && c.container.Kind() == reflect.Map {
+				c.current = c.container.Key()
+			}
+			continue
+		case "endkeys":
+			c.current = elemType(c.container)
+			continue
+		}
+
+		for _, single := range strings.Split(alternatives, "|") {
+			name, param, ok := strings.Cut(single, "=")
+			if expanded, isAlias := c.aliases[name]; !ok && isAlias && !c.expanding[name] {
+				c.expanding[name] = true
+				err := c.check(expanded)
+				delete(c.expanding, name)
+				if err != nil {
+					return err
+				}
+				continue
+			}
+
+			if !ok || !numericParamTags[name] || (valueParamTags[name] && !isNumberType(c.current)) {
+				continue
+			}
+
+			if !isNumericParam(param, c.current) {
+				return fmt.Errorf("invalid param %q for tag %s", param, name)
+			}
+		}
+	}
+
+	return nil
+}
+
+// indirectType untuk mengambil type di balik pointer
+func indirectType(typ reflect.Type) reflect.Type {
+	for typ != nil && typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+
+	return typ
+}
+
+// elemType untuk mengambil type elemen dari slice, array atau map, nil jika type tidak diketahui
+func elemType(typ reflect.Type) reflect.Type {
+	if typ == nil {
+		return nil
+	}
+
+	switch typ.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return typ.Elem()
+	}
+
+	return nil
+}
+
+// isNumberType untuk mengecek type field (atau pointer nya) berupa angka
+func isNumberType(fieldType reflect.Type) bool {
+	fieldType = indirectType(fieldType)
+	if fieldType == nil {
+		return false
+	}
+
+	switch fieldType.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+
+	return false
+}
+
+// isNumericParam untuk mengecek parameter berupa angka, atau durasi untuk field time.Duration
+// type nil (misal isi alias) belum diketahui sehingga durasi juga diterima
+func isNumericParam(param string, fieldType reflect.Type) bool {
+	if _, err := strconv.ParseFloat(param, 64); err == nil {
+		return true
+	}
+
+	if fieldType = indirectType(fieldType); fieldType == nil || fieldType == reflect.TypeOf(time.Duration(0)) {
+		_, err := time.ParseDuration(param)
+		return err == nil
+	}
+
+	return false
+}
+
+// positionError untuk membuat error dengan posisi file, baris dan kolom dari node
+func positionError(name string, node *yaml.Node, format string, args ...any) error {
+	return fmt.Errorf("%s:%d:%d: %s", name, node.Line, node.Column, fmt.Sprintf(format, args...))
+}
@@ -0,0 +1,208 @@
+package validation
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/go-playground/validator/v10"
+	"gopkg.in/yaml.v3"
+)
+
+// TagInSet untuk validasi value harus ada di dalam set dengan nama sesuai parameter
+// contoh : `validate:"inset=category"`
+const TagInSet = "inset"
+
+// nama set bawaan
+const (
+	SetCategory = "category"
+	SetGender   = "gender"
+)
+
+// ValueSet adalah daftar value yang valid dengan sebuah nama
+type ValueSet struct {
+	Name   string   `json:"name" yaml:"name"`
+	Values []string `json:"values" yaml:"values"`
+
+	// IgnoreCase untuk mencocokkan value tanpa membedakan huruf besar dan kecil
+	IgnoreCase bool `json:"ignore_case,omitempty" yaml:"ignore_case,omitempty"`
+}
+
+// Contains untuk mengecek value ada di dalam set
+func (s ValueSet) Contains(value string) bool {
+	if !s.IgnoreCase {
+		return slices.Contains(s.Values, value)
+	}
+
+	return slices.ContainsFunc(s.Values, func(allowed string) bool {
+		return strings.EqualFold(allowed, value)
+	})
+}
+
+// sets berisi semua set yang sudah didaftarkan, diawali dengan set category dan gender
+// setTags memetakan tag yang didaftarkan dengan WithSetTag ke nama set nya, diawali dengan tag category dan gender
+var (
+	setsMutex sync.RWMutex
+	sets      = map[string]ValueSet{
+		SetCategory: {Name: SetCategory, Values: Categories},
+		SetGender:   {Name: SetGender, Values: Genders},
+	}
+	setTags = map[string]string{
+		TagCategory: SetCategory,
+		TagGender:   SetGender,
+	}
+)
+
+// WithSetTag untuk mendaftarkan custom tag yang memvalidasi value harus ada di set dengan nama set
+// message dan suggestion dari tag ini berisi daftar value dari set
+// contoh : validation.New(validation.WithSetTag("currency", "currency")) lalu `validate:"currency"`
+func WithSetTag(tag, set string) Option {
+	return func(validate *validator.Validate) error {
+		err := validate.RegisterValidation(tag, func(field validator.FieldLevel) bool {
+			return inSet(set, field)
+		})
+		if err != nil {
+			return err
+		}
+
+		setsMutex.Lock()
+		defer setsMutex.Unlock()
+
+		setTags[tag] = set
+		return nil
+	}
+}
+
+// RegisterSet untuk menambahkan atau mengganti set
+// contoh : validation.RegisterSet(validation.ValueSet{Name: "currency", Values: []string{"IDR", "USD"}, IgnoreCase: true})
+func RegisterSet(set ValueSet) error {
+	if set.Name == "" {
+		return fmt.Errorf("set name must not be empty")
+	}
+
+	if len(set.Values) == 0 {
+		return fmt.Errorf("set %q must have at least one value", set.Name)
+	}
+
+	setsMutex.Lock()
+	defer setsMutex.Unlock()
+
+	set.Values = slices.Clone(set.Values)
+	sets[set.Name] = set
+	return nil
+}
+
+// LookupSet untuk mengambil set berdasarkan nama
+func LookupSet(name string) (ValueSet, bool) {
+	setsMutex.RLock()
+	defer setsMutex.RUnlock()
+
+	set, ok := sets[name]
+	set.Values = slices.Clone(set.Values)
+	return set, ok
+}
+
+// SetNames untuk mengambil nama semua set yang sudah didaftarkan, terurut
+func SetNames() []string {
+	setsMutex.RLock()
+	defer setsMutex.RUnlock()
+
+	names := make([]string, 0, len(sets))
+	for name := range sets {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+	return names
+}
+
+// LoadSets untuk mendaftarkan set dari file YAML atau JSON
+// setiap set boleh ditulis sebagai list value, atau object dengan values dan ignore_case
+//
+// contoh isi file :
+//
+//	gender: [male, female]
+//	currency:
+//	  values: [IDR, USD]
+//	  ignore_case: true
+func LoadSets(path string) error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	var document map[string]yaml.Node
+	if err := yaml.Unmarshal(content, &document); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+
+	loaded := make([]ValueSet, 0, len(document))
+	for name, node := range document {
+		set := ValueSet{Name: name}
+
+		var err error
+		if node.Kind == yaml.SequenceNode {
+			err = node.Decode(&set.Values)
+		} else {
+			err = node.Decode(&set)
+			set.Name = name
+		}
+
+		if err != nil {
+			return positionError(path, &node, "set %s: %v", name, err)
+		}
+
+		if len(set.Values) == 0 {
+			return positionError(path, &node, "set %q must have at least one value", name)
+		}
+
+		loaded = append(loaded, set)
+	}
+
+	for _, set := range loaded {
+		if err := RegisterSet(set); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ValidateInSet untuk validasi value string harus ada di set dengan nama sesuai parameter
+// set yang belum didaftarkan selalu dianggap tidak valid
+// contoh : `validate:"inset=gender"`
+func ValidateInSet(field validator.FieldLevel) bool {
+	return inSet(field.Param(), field)
+}
+
+// inSet untuk mengecek value string dari field ada di set dengan nama name
+func inSet(name string, field validator.FieldLevel) bool {
+	value, ok := field.Field().Interface().(string)
+	if !ok {
+		return false
+	}
+
+	return InSet(name, value)
+}
+
+// fieldSet untuk mengambil set dari sebuah error field
+// untuk tag inset nama set diambil dari parameter, untuk tag yang didaftarkan dengan WithSetTag dari setTags
+// tag lain tidak punya set walaupun ada set dengan nama yang sama, misal set "email" tidak dipakai untuk tag email
+func fieldSet(fieldError validator.FieldError) (ValueSet, bool) {
+	if fieldError.Tag() == TagInSet || fieldError.ActualTag() == TagInSet {
+		return LookupSet(fieldError.Param())
+	}
+
+	setsMutex.RLock()
+	name, ok := setTags[fieldError.ActualTag()]
+	setsMutex.RUnlock()
+
+	if !ok {
+		return ValueSet{}, false
+	}
+
+	return LookupSet(name)
+}
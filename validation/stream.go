@@ -0,0 +1,283 @@
+package validation
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// DefaultMaxRecordSize adalah ukuran maksimal satu record dalam byte
+const DefaultMaxRecordSize = 1 << 20
+
+// ErrRecordTooLarge adalah error jika satu record lebih besar dari MaxRecordSize
+var ErrRecordTooLarge = errors.New("record exceeds max record size")
+
+// ErrNullRecord adalah error di RecordResult.Err jika T berupa pointer dan record nya null
+var ErrNullRecord = errors.New("record must be a JSON object")
+
+// RecordResult adalah hasil validasi satu record dari stream
+type RecordResult[T any] struct {
+	// Index adalah urutan record, dimulai dari 0
+	Index int
+
+	// Offset adalah posisi byte awal record di dalam input
+	Offset int64
+
+	// Value adalah record yang sudah di-decode
+	Value T
+
+	// Err berisi validator.ValidationErrors jika record tidak valid,
+	// error decode jika isi record tidak cocok dengan type T, atau ErrNullRecord untuk record null
+	// saat T berupa pointer, nil jika valid
+	Err error
+}
+
+// StreamSummary adalah ringkasan hasil validasi stream
+type StreamSummary struct {
+	Records   int  `json:"records"`
+	Invalid   int  `json:"invalid"`
+	Truncated bool `json:"truncated"`
+}
+
+// StreamValidator untuk validasi record dari io.Reader satu per satu tanpa membaca seluruh input ke memory
+// input berupa NDJSON (satu JSON per baris) atau satu JSON array, formatnya dideteksi dari karakter pertama
+// T boleh berupa struct atau pointer ke struct, misal StreamValidator[*Customer]
+//
+// memory yang dipakai dibatasi oleh MaxRecordSize karena hanya satu record yang di-decode dalam satu waktu
+// contoh : validation.NewStreamValidator[Customer](validate).Run(ctx, file, func(result RecordResult[Customer]) error {...})
+type StreamValidator[T any] struct {
+	// Validate adalah validator untuk setiap record
+	Validate *validator.Validate
+
+	// MaxErrors adalah jumlah record tidak valid maksimal, setelah itu stream berhenti, 0 berarti tanpa batas
+	MaxErrors int
+
+	// MaxRecordSize adalah ukuran maksimal satu record dalam byte, 0 berarti tanpa batas
+	MaxRecordSize int64
+
+	// SkipValid true jika hanya record tidak valid yang dikirim ke callback atau channel
+	SkipValid bool
+}
+
+// NewStreamValidator untuk membuat StreamValidator dengan DefaultMaxRecordSize
+func NewStreamValidator[T any](validate *validator.Validate) *StreamValidator[T] {
+	return &StreamValidator[T]{
+		Validate:      validate,
+		MaxRecordSize: DefaultMaxRecordSize,
+	}
+}
+
+// Run untuk membaca dan validasi setiap record lalu memanggil fn dengan hasilnya
+// error dari fn menghentikan stream dan dikembalikan apa adanya
+// JSON yang rusak, record yang terlalu besar dan context yang dibatalkan juga menghentikan stream
+func (s *StreamValidator[T]) Run(ctx context.Context, reader io.Reader, fn func(result RecordResult[T]) error) (StreamSummary, error) {
+	var summary StreamSummary
+
+	limited := &recordReader{reader: reader, max: s.MaxRecordSize}
+	decoder := json.NewDecoder(limited)
+
+	array, err := isJSONArray(limited)
+	if err != nil {
+		return summary, err
+	}
+
+	if array {
+		if _, err := decoder.Token(); err != nil {
+			return summary, err
+		}
+	}
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return summary, err
+		}
+
+		if array && !decoder.More() {
+			break
+		}
+
+		limited.start = decoder.InputOffset()
+
+		var raw json.RawMessage
+		if err := decoder.Decode(&raw); err != nil {
+			if !array && errors.Is(err, io.EOF) {
+				break
+			}
+			return summary, s.streamError(summary.Records, limited, err)
+		}
+
+		result := RecordResult[T]{
+			Index:  summary.Records,
+			Offset: decoder.InputOffset() - int64(len(raw)),
+		}
+
+		result.Err = json.Unmarshal(raw, &result.Value)
+		if result.Err == nil {
+			result.Err = s.validateRecord(ctx, &result.Value)
+
+			var validationErrors validator.ValidationErrors
+			if result.Err != nil && !errors.As(result.Err, &validationErrors) && !errors.Is(result.Err, ErrNullRecord) {
+				return summary, result.Err
+			}
+		}
+
+		summary.Records++
+		if result.Err != nil {
+			summary.Invalid++
+		}
+
+		if result.Err != nil || !s.SkipValid {
+			if err := fn(result); err != nil {
+				return summary, err
+			}
+		}
+
+		if s.MaxErrors > 0 && summary.Invalid >= s.MaxErrors {
+			summary.Truncated = hasMoreRecords(decoder, array)
+			return summary, nil
+		}
+	}
+
+	if array {
+		if _, err := decoder.Token(); err != nil {
+			return summary, s.streamError(summary.Records, limited, err)
+		}
+
+		if _, err := decoder.Token(); !errors.Is(err, io.EOF) {
+			return summary, fmt.Errorf("record %d: unexpected data after JSON array", summary.Records)
+		}
+	}
+
+	return summary, nil
+}
+
+// Results sama seperti Run tetapi hasil dikirim ke channel, channel ditutup setelah stream selesai
+// wait dipanggil setelah channel habis dibaca untuk mengambil ringkasan dan error stream
+// channel harus dibaca sampai habis atau context dibatalkan supaya goroutine stream berhenti
+func (s *StreamValidator[T]) Results(ctx context.Context, reader io.Reader) (results <-chan RecordResult[T], wait func() (StreamSummary, error)) {
+	channel := make(chan RecordResult[T])
+	done := make(chan struct{})
+
+	var summary StreamSummary
+	var err error
+
+	go func() {
+		defer close(done)
+		defer close(channel)
+
+		summary, err = s.Run(ctx, reader, func(result RecordResult[T]) error {
+			select {
+			case channel <- result:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		})
+	}()
+
+	return channel, func() (StreamSummary, error) {
+		<-done
+		return summary, err
+	}
+}
+
+// streamError untuk menambahkan index record ke error decode, record yang terlalu besar memakai ErrRecordTooLarge
+func (s *StreamValidator[T]) streamError(index int, limited *recordReader, err error) error {
+	if limited.exceeded {
+		return fmt.Errorf("record %d at offset %d: %w", index, limited.start, ErrRecordTooLarge)
+	}
+
+	return fmt.Errorf("record %d: %w", index, err)
+}
+
+// validateRecord untuk validasi satu record, pointer di T dibuka lebih dulu
+// supaya validator menerima *Customer, bukan **Customer yang selalu ditolak sebagai InvalidValidationError
+func (s *StreamValidator[T]) validateRecord(ctx context.Context, value *T) error {
+	current := reflect.ValueOf(value)
+	for current.Elem().Kind() == reflect.Pointer {
+		if current.Elem().IsNil() {
+			return ErrNullRecord
+		}
+		current = current.Elem()
+	}
+
+	if err := CheckStruct(s.Validate, current.Interface()); err != nil {
+		return err
+	}
+
+	return s.Validate.StructCtx(ctx, current.Interface())
+}
+
+// isJSONArray untuk mengecek karakter pertama input selain spasi adalah [
+func isJSONArray(reader *recordReader) (bool, error) {
+	first, err := reader.peek()
+	if errors.Is(err, io.EOF) {
+		return false, nil
+	}
+
+	return first == '[', err
+}
+
+// hasMoreRecords untuk mengecek masih ada record setelah stream berhenti karena MaxErrors
+func hasMoreRecords(decoder *json.Decoder, array bool) bool {
+	if array {
+		return decoder.More()
+	}
+
+	_, err := decoder.Token()
+	return err == nil
+}
+
+// recordReader membatasi jumlah byte yang dibaca sejak awal record supaya memory tetap terbatas
+type recordReader struct {
+	reader   io.Reader
+	max      int64
+	start    int64
+	read     int64
+	exceeded bool
+
+	// peeked berisi byte yang sudah dibaca saat mendeteksi format
+	peeked []byte
+}
+
+// Read untuk membaca input, error ErrRecordTooLarge jika record sekarang melebihi max
+func (r *recordReader) Read(p []byte) (int, error) {
+	if r.max > 0 && r.read-r.start > r.max {
+		r.exceeded = true
+		return 0, ErrRecordTooLarge
+	}
+
+	if len(r.peeked) > 0 {
+		n := copy(p, r.peeked)
+		r.peeked = r.peeked[n:]
+		r.read += int64(n)
+		return n, nil
+	}
+
+	n, err := r.reader.Read(p)
+	r.read += int64(n)
+	return n, err
+}
+
+// peek untuk mengambil karakter pertama selain spasi tanpa menghilangkannya dari input
+func (r *recordReader) peek() (byte, error) {
+	buffer := make([]byte, 512)
+	for {
+		n, err := r.reader.Read(buffer)
+		r.peeked = append(r.peeked, buffer[:n]...)
+
+		if trimmed := bytes.TrimLeft(r.peeked, " \t\r\n"); len(trimmed) > 0 {
+			return trimmed[0], nil
+		}
+
+		if err != nil {
+			return 0, err
+		}
+	}
+}
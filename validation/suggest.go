@@ -0,0 +1,174 @@
+package validation
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// DefaultSuggestionDistance adalah jarak edit maksimal antara value dan saran
+const DefaultSuggestionDistance = 2
+
+// PlaceholderSuggestion berisi saran value di dalam message saran
+const PlaceholderSuggestion = "{suggestion}"
+
+// CommonEmailDomains adalah daftar domain email yang sering dipakai, dipakai oleh NewSuggester
+var CommonEmailDomains = []string{
+	"gmail.com", "yahoo.com", "yahoo.co.id", "hotmail.com", "outlook.com",
+	"icloud.com", "live.com", "ymail.com", "protonmail.com",
+}
+
+// Suggester untuk mencari saran value ("did you mean") dari error field
+// tag yang punya set (inset, category, gender dan tag dari WithSetTag) dan oneof disarankan value terdekat dari daftarnya
+// tag email disarankan domain terdekat dari Domains
+type Suggester struct {
+	// Domains adalah daftar domain email yang bisa disarankan
+	Domains []string
+
+	// EmailTags adalah tag validasi yang value nya dianggap email, dicocokkan dengan ActualTag
+	// alias tidak perlu didaftarkan, misal app_email yang gagal di email tetap disarankan
+	// tetapi app_email yang gagal di min tidak, karena email nya sudah valid
+	EmailTags []string
+
+	// MaxDistance adalah jarak edit maksimal antara value dan saran
+	MaxDistance int
+}
+
+// NewSuggester untuk membuat suggester dengan CommonEmailDomains dan DefaultSuggestionDistance
+// contoh : catalog.SetSuggester(validation.NewSuggester())
+func NewSuggester() *Suggester {
+	return &Suggester{
+		Domains:     slices.Clone(CommonEmailDomains),
+		EmailTags:   []string{"email"},
+		MaxDistance: DefaultSuggestionDistance,
+	}
+}
+
+// Suggest untuk mencari saran value dari satu error field
+// false jika tidak ada value yang cukup dekat
+func (s *Suggester) Suggest(fieldError validator.FieldError) (string, bool) {
+	value, ok := fieldError.Value().(string)
+	if !ok || value == "" {
+		return "", false
+	}
+
+	if slices.Contains(s.EmailTags, fieldError.ActualTag()) {
+		return s.suggestEmail(value)
+	}
+
+	if set, ok := fieldSet(fieldError); ok {
+		return s.nearest(value, set.Values, set.IgnoreCase)
+	}
+
+	if fieldError.ActualTag() == "oneof" {
+		options := strings.Fields(fieldError.Param())
+		for i, option := range options {
+			options[i] = strings.Trim(option, "'")
+		}
+		return s.nearest(value, options, false)
+	}
+
+	return "", false
+}
+
+// suggestEmail untuk mengganti domain email dengan domain terdekat dari Domains
+func (s *Suggester) suggestEmail(value string) (string, bool) {
+	at := strings.LastIndex(value, "@")
+	if at < 0 {
+		return "", false
+	}
+
+	domain, ok := s.nearest(value[at+1:], s.Domains, true)
+	if !ok {
+		return "", false
+	}
+
+	return value[:at+1] + domain, true
+}
+
+// nearest untuk mencari value di options dengan jarak edit terkecil
+// value yang sama persis dengan salah satu option tidak disarankan
+func (s *Suggester) nearest(value string, options []string, ignoreCase bool) (string, bool) {
+	compared := value
+	if ignoreCase {
+		compared = strings.ToLower(value)
+	}
+
+	best, bestDistance := "", s.MaxDistance+1
+	for _, option := range options {
+		candidate := option
+		if ignoreCase {
+			candidate = strings.ToLower(option)
+		}
+
+		distance := editDistance(compared, candidate)
+		if distance == 0 {
+			return "", false
+		}
+
+		// jika jarak sama, option yang lebih dulu di daftar yang dipakai
+		if distance < bestDistance {
+			best, bestDistance = option, distance
+		}
+	}
+
+	return best, best != ""
+}
+
+// editDistance untuk menghitung jarak Levenshtein antara a dan b per rune
+func editDistance(a, b string) int {
+	source, target := []rune(a), []rune(b)
+
+	previous := make([]int, len(target)+1)
+	current := make([]int, len(target)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(source); i++ {
+		current[0] = i
+		for j := 1; j <= len(target); j++ {
+			cost := 1
+			if source[i-1] == target[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+
+	return previous[len(target)]
+}
+
+// SetSuggester untuk menambahkan saran value ke message dari catalog, nil untuk mematikan saran
+// contoh : catalog.SetSuggester(validation.NewSuggester())
+func (c *Catalog) SetSuggester(suggester *Suggester) {
+	c.suggester = suggester
+}
+
+// Suggestion untuk mengambil saran value dari satu error field, kosong jika tidak ada suggester atau saran
+func (c *Catalog) Suggestion(fieldError validator.FieldError) string {
+	if c.suggester == nil {
+		return ""
+	}
+
+	suggestion, _ := c.suggester.Suggest(fieldError)
+	return suggestion
+}
+
+// withSuggestion untuk menambahkan message saran di belakang message sesuai locale
+func (c *Catalog) withSuggestion(message string, messages map[string]string, fieldError validator.FieldError) string {
+	suggestion := c.Suggestion(fieldError)
+	if suggestion == "" {
+		return message
+	}
+
+	format, ok := messages[keySuggestion]
+	if !ok {
+		format = c.messages[LocaleEnglish][keySuggestion]
+	}
+
+	return fmt.Sprintf("%s, %s", message, strings.ReplaceAll(format, PlaceholderSuggestion, suggestion))
+}
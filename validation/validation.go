@@ -0,0 +1,71 @@
+package validation
+
+import (
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// Option untuk menambahkan konfigurasi ke validator saat dibuat menggunakan New
+type Option func(validate *validator.Validate) error
+
+// New untuk membuat validator yang sudah berisi custom tag dan alias milik project
+// contoh : validate, err := validation.New(validation.WithAlias("app_phone", "required,e164"))
+func New(options ...Option) (*validator.Validate, error) {
+	validate := validator.New()
+
+	// register custom tag dan alias bawaan project terlebih dahulu
+	// agar option yang dikirim bisa menimpa jika diperlukan
+	defaults := []Option{
+		WithValidation(TagCategory, ValidateCategory),
+		WithParamValidation(TagMinCategory, IntParam, ValidateMinCategory),
+		WithValidation(TagGender, ValidateGender),
+		WithValidation(TagInSet, ValidateInSet),
+		WithAlias(AliasAppEmail, AppEmailTags),
+	}
+
+	for _, option := range append(defaults, options...) {
+		if err := option(validate); err != nil {
+			forgetValidator(validate)
+			return nil, err
+		}
+	}
+
+	return validate, nil
+}
+
+// forgetValidator untuk menghapus pengecekan parameter dan rules milik validator yang tidak dipakai lagi
+func forgetValidator(validate *validator.Validate) {
+	forgetParamChecks(validate)
+	forgetRules(validate)
+}
+
+// WithValidation untuk mendaftarkan custom tag beserta function validasinya
+// sama seperti validate.RegisterValidation(tag, fn, callValidationEvenIfNull)
+func WithValidation(tag string, fn validator.Func, callValidationEvenIfNull ...bool) Option {
+	return func(validate *validator.Validate) error {
+		if err := validate.RegisterValidation(tag, fn, callValidationEvenIfNull...); err != nil {
+			return err
+		}
+
+		// tag yang sebelumnya didaftarkan dengan WithParamValidation tidak lagi punya parameter dengan type
+		setParamCheck(validate, tag, nil)
+		return nil
+	}
+}
+
+// WithAlias untuk mendaftarkan alias dari beberapa tag
+// sama seperti validate.RegisterAlias(alias, tags)
+func WithAlias(alias, tags string) Option {
+	return func(validate *validator.Validate) (err error) {
+		// RegisterAlias panic untuk nama alias yang dipakai oleh validator sendiri, misal dive
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				err = fmt.Errorf("invalid alias %q: %v", alias, recovered)
+			}
+		}()
+
+		validate.RegisterAlias(alias, tags)
+		return nil
+	}
+}
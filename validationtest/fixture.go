@@ -0,0 +1,173 @@
+package validationtest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"gopkg.in/yaml.v3"
+)
+
+// Fixture adalah satu skenario validasi yang ditulis di file YAML atau JSON
+// jika Type diisi, Input di decode ke type yang terdaftar (menggunakan tag json)
+// jika Tag diisi validasi memakai VarCtx, jika kosong memakai StructCtx
+//
+// contoh isi file fixture :
+//
+//	# testdata/login.yaml
+//	- name: test validasi struct failed
+//	  type: LoginRequest
+//	  input: {username: reo, password: "123"}
+//	  expect_errors:
+//	    - {field: Username, tag: email}
+//	- name: test validation pattern ip address success
+//	  tag: ip
+//	  input: 172.18.41.238
+type Fixture struct {
+	Name         string    `yaml:"name"`
+	Type         string    `yaml:"type"`
+	Tag          string    `yaml:"tag"`
+	Input        yaml.Node `yaml:"input"`
+	ExpectErrors []Failure `yaml:"expect_errors"`
+
+	// File dan Line adalah lokasi fixture, dipakai saat melaporkan test yang gagal
+	File string `yaml:"-"`
+	Line int    `yaml:"-"`
+}
+
+// FixtureTypes memetakan nama type yang dipakai di fixture ke type Go
+// contoh : validationtest.FixtureTypes{"LoginRequest": validationtest.TypeOf[LoginRequest]()}
+type FixtureTypes map[string]reflect.Type
+
+// TypeOf untuk mengambil reflect.Type dari T
+func TypeOf[T any]() reflect.Type {
+	return reflect.TypeOf((*T)(nil)).Elem()
+}
+
+// LoadFixtures untuk membaca semua fixture dari satu file YAML atau JSON
+// file harus berisi list fixture
+func LoadFixtures(path string) ([]Fixture, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var document yaml.Node
+	if err := yaml.Unmarshal(content, &document); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	if len(document.Content) == 0 {
+		return nil, nil
+	}
+
+	root := document.Content[0]
+	if root.Kind != yaml.SequenceNode {
+		return nil, fmt.Errorf("%s:%d: fixture file must contain a list of fixtures", path, root.Line)
+	}
+
+	fixtures := make([]Fixture, 0, len(root.Content))
+	for _, node := range root.Content {
+		var fixture Fixture
+		if err := node.Decode(&fixture); err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", path, node.Line, err)
+		}
+
+		if fixture.Type == "" && fixture.Tag == "" {
+			return nil, fmt.Errorf("%s:%d: fixture %q must have a type or a tag", path, node.Line, fixture.Name)
+		}
+
+		fixture.File = path
+		fixture.Line = node.Line
+		fixtures = append(fixtures, fixture)
+	}
+
+	return fixtures, nil
+}
+
+// RunFixtures untuk menjalankan semua fixture dari file yang cocok dengan pattern sebagai subtest
+// setiap file menjadi subtest sendiri, lalu setiap fixture menjadi subtest di dalamnya
+// contoh : validationtest.RunFixtures(t, validate, "testdata/*.yaml", types)
+func RunFixtures(t *testing.T, validate *validator.Validate, pattern string, types FixtureTypes) {
+	t.Helper()
+
+	paths, err := filepath.Glob(pattern)
+	if err != nil {
+		t.Fatalf("invalid fixture pattern %q: %v", pattern, err)
+	}
+
+	if len(paths) == 0 {
+		t.Fatalf("no fixture file matches %q", pattern)
+	}
+
+	for _, path := range paths {
+		t.Run(filepath.Base(path), func(t *testing.T) {
+			fixtures, err := LoadFixtures(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for _, fixture := range fixtures {
+				t.Run(fixture.Name, func(t *testing.T) {
+					RunFixture(t, validate, fixture, types)
+				})
+			}
+		})
+	}
+}
+
+// RunFixture untuk menjalankan satu fixture dan melaporkan lokasi file dan baris jika gagal
+func RunFixture(t testing.TB, validate *validator.Validate, fixture Fixture, types FixtureTypes) bool {
+	t.Helper()
+
+	location := fmt.Sprintf("%s:%d", fixture.File, fixture.Line)
+
+	input, err := fixture.decodeInput(types)
+	if err != nil {
+		t.Errorf("%s: %v", location, err)
+		return false
+	}
+
+	if fixture.Tag != "" {
+		err = validate.VarCtx(context.Background(), input, fixture.Tag)
+	} else {
+		err = validate.StructCtx(context.Background(), input)
+	}
+
+	return AssertFailures(t, err, fixture.ExpectErrors, "fixture %q at %s", fixture.Name, location)
+}
+
+// decodeInput untuk decode input fixture ke type yang terdaftar
+// input di decode dari YAML ke JSON lebih dulu agar tag json pada struct tetap dipakai
+func (f Fixture) decodeInput(types FixtureTypes) (any, error) {
+	var raw any
+	if err := f.Input.Decode(&raw); err != nil {
+		return nil, fmt.Errorf("invalid input: %w", err)
+	}
+
+	if f.Type == "" {
+		return raw, nil
+	}
+
+	inputType, ok := types[f.Type]
+	if !ok {
+		return nil, fmt.Errorf("type %q is not registered", f.Type)
+	}
+
+	content, err := json.Marshal(raw)
+	if err != nil {
+		return nil, fmt.Errorf("invalid input: %w", err)
+	}
+
+	value := reflect.New(inputType)
+	if err := json.Unmarshal(content, value.Interface()); err != nil {
+		return nil, fmt.Errorf("input does not match type %q: %w", f.Type, err)
+	}
+
+	return value.Elem().Interface(), nil
+}
@@ -0,0 +1,101 @@
+// Package validationtest berisi helper untuk menjalankan skenario validasi secara table-driven
+// setiap skenario dijalankan sebagai subtest dan dicek field serta tag yang gagal, bukan hanya error nil atau tidak
+package validationtest
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"go-validation/validation"
+)
+
+// Failure adalah field dan tag yang diharapkan gagal
+// Field berisi path dari error (lihat validation.Path), misal "addresses[0].city"
+// untuk validasi variabel, Field berisi "" atau index/key seperti "[0]"
+type Failure struct {
+	Field string `json:"field,omitempty" yaml:"field"`
+	Tag   string `json:"tag" yaml:"tag"`
+}
+
+// StructScenario adalah skenario validasi struct menggunakan StructCtx
+// ExpectErrors kosong berarti input harus valid
+type StructScenario[T any] struct {
+	Name         string
+	Input        T
+	ExpectErrors []Failure
+}
+
+// VarScenario adalah skenario validasi variabel menggunakan VarCtx
+// jika Other diisi, validasi memakai VarWithValueCtx, misal untuk tag eqfield
+type VarScenario[T any] struct {
+	Name         string
+	Input        T
+	Other        any
+	Tag          string
+	ExpectErrors []Failure
+}
+
+// RunStruct untuk menjalankan setiap skenario struct sebagai subtest
+func RunStruct[T any](t *testing.T, validate *validator.Validate, scenarios []StructScenario[T]) {
+	t.Helper()
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.Name, func(t *testing.T) {
+			err := validate.StructCtx(context.Background(), scenario.Input)
+
+			AssertFailures(t, err, scenario.ExpectErrors)
+		})
+	}
+}
+
+// RunVar untuk menjalankan setiap skenario variabel sebagai subtest
+func RunVar[T any](t *testing.T, validate *validator.Validate, scenarios []VarScenario[T]) {
+	t.Helper()
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.Name, func(t *testing.T) {
+			var err error
+			if scenario.Other != nil {
+				err = validate.VarWithValueCtx(context.Background(), scenario.Input, scenario.Other, scenario.Tag)
+			} else {
+				err = validate.VarCtx(context.Background(), scenario.Input, scenario.Tag)
+			}
+
+			AssertFailures(t, err, scenario.ExpectErrors)
+		})
+	}
+}
+
+// AssertFailures untuk mengecek field dan tag yang gagal sama persis dengan expected, urutan tidak diperhatikan
+// error selain validator.ValidationErrors dianggap gagal
+// msgAndArgs ditambahkan ke pesan gagal, sama seperti di package assert
+func AssertFailures(t testing.TB, err error, expected []Failure, msgAndArgs ...any) bool {
+	t.Helper()
+
+	if err == nil {
+		return assert.ElementsMatch(t, expected, []Failure{}, msgAndArgs...)
+	}
+
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		return assert.Fail(t, "unexpected error: "+err.Error(), msgAndArgs...)
+	}
+
+	return assert.ElementsMatch(t, expected, Failures(validationErrors), msgAndArgs...)
+}
+
+// Failures untuk mengubah validator.ValidationErrors menjadi list Failure
+func Failures(validationErrors validator.ValidationErrors) []Failure {
+	failures := make([]Failure, 0, len(validationErrors))
+	for _, fieldError := range validationErrors {
+		failures = append(failures, Failure{
+			Field: validation.Path(fieldError),
+			Tag:   fieldError.Tag(),
+		})
+	}
+
+	return failures
+}